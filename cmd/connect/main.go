@@ -28,6 +28,8 @@ func main() {
 
 	// 初始化Rpc Client
 	rpc.InitLogicIntClient(config.RPCAddr.LogicRPCAddr)
+	rpc.InitLogicExtConn(config.RPCAddr.LogicRPCAddr)
+	rpc.InitBusinessExtConn(config.RPCAddr.BusinessRPCAddr)
 
 	// 启动TCP长链接服务器
 	go func() {
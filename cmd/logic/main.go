@@ -4,6 +4,8 @@ import (
 	"gim/config"
 	"gim/internal/logic/api"
 	"gim/internal/logic/app"
+	"gim/internal/logic/domain/message/repo"
+	"gim/internal/logic/domain/moderation"
 	"gim/internal/logic/proxy"
 	"gim/pkg/db"
 	"gim/pkg/interceptor"
@@ -11,6 +13,7 @@ import (
 	"gim/pkg/pb"
 	"gim/pkg/rpc"
 	"gim/pkg/urlwhitelist"
+	"gim/pkg/util"
 	"net"
 	"os"
 	"os/signal"
@@ -30,6 +33,15 @@ func main() {
 	db.InitMysql(config.Logic.MySQL)
 	db.InitRedis(config.Logic.RedisIP, config.Logic.RedisPassword)
 
+	// 加载消息表的分表配置，重新分表时会定时重新加载
+	err := repo.MessageShardRepo.Start()
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化全局id生成器
+	util.InitUID(db.DB.DB())
+
 	// 初始化APP代理
 	initProxy()
 
@@ -37,6 +49,19 @@ func main() {
 	rpc.InitConnectIntClient(config.RPCAddr.ConnectRPCAddr)
 	rpc.InitBusinessIntClient(config.RPCAddr.BusinessRPCAddr)
 
+	// 启动定时消息的发送和阅后即焚消息的销毁
+	app.ScheduleApp.Start()
+	app.MessageApp.StartExpire()
+
+	// 加载敏感词，并且定时重新加载
+	moderation.SensitiveWordFilter.Start()
+
+	// 启动webhook事件的投递
+	app.WebhookApp.Start()
+
+	// 按照保留策略定时清理过期的消息
+	app.RetentionApp.Start()
+
 	// 这里有个鉴权的过程，应该是设备的鉴权，登录鉴权在SignIn处理，每收到一个请求都会验证设备是否符合要求
 	server := grpc.NewServer(grpc.UnaryInterceptor(interceptor.NewInterceptor("logic_interceptor", urlwhitelist.Logic)))
 
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"gim/config"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/service"
+	"gim/pkg/db"
+	"gim/pkg/logger"
+	"os"
+)
+
+const usage = `消息表重新分表工具，logic服务不需要停机
+用法：reshard <command> [flags]
+  ddl     -table_num N [-version V]  打印分表的建表语句，默认为下一个版本
+  start   -table_num N               创建新分表，开启双写
+  copy    [-batch_size B]            复制存量消息到新分表，可以重复执行
+  cutover [-batch_size B]            copy完成后切换到新分表，并补齐复制之后新分表写入失败的消息，完成后旧分表需要手动删除
+每一步都会等待所有logic实例加载最新的分表配置，有实例没有加载时返回错误，可以重复执行
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Print(usage)
+		os.Exit(1)
+	}
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	tableNum := flags.Int("table_num", 0, "新分表的数量")
+	version := flags.Int("version", -1, "分表版本，默认为下一个版本")
+	batchSize := flags.Int64("batch_size", 1000, "每次复制的主键范围")
+	_ = flags.Parse(os.Args[2:])
+
+	logger.Init()
+	db.InitMysql(config.Logic.MySQL)
+	db.InitRedis(config.Logic.RedisIP, config.Logic.RedisPassword)
+
+	ctx := context.TODO()
+	var err error
+	switch os.Args[1] {
+	case "ddl":
+		var layout model.MessageLayout
+		layout, err = service.MessageShardService.NextLayout(*tableNum)
+		if err != nil {
+			break
+		}
+		if *version >= 0 {
+			layout.Version = *version
+		}
+		if layout.TableNum <= 0 {
+			fmt.Print(usage)
+			os.Exit(1)
+		}
+		var ddl string
+		ddl, err = service.MessageShardService.DDL(ctx, layout)
+		if err == nil {
+			fmt.Print(ddl)
+		}
+	case "start":
+		if *tableNum <= 0 {
+			fmt.Print(usage)
+			os.Exit(1)
+		}
+		err = service.MessageShardService.Start(ctx, *tableNum)
+		if err == nil {
+			fmt.Println("双写已开启，请执行copy复制存量消息")
+		}
+	case "copy":
+		var count int64
+		count, err = service.MessageShardService.Copy(ctx, *batchSize)
+		if err == nil {
+			fmt.Println("复制完成，复制消息数量：", count, "，请执行cutover切换到新分表")
+		}
+	case "cutover":
+		err = service.MessageShardService.Cutover(ctx, *batchSize)
+		if err == nil {
+			fmt.Println("已经切换到新分表")
+		}
+	default:
+		fmt.Print(usage)
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Println("reshard error:", err)
+		os.Exit(1)
+	}
+}
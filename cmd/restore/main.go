@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"gim/config"
+	"gim/internal/logic/domain/message/repo"
+	"gim/internal/logic/domain/retention"
+	"gim/pkg/db"
+	"gim/pkg/logger"
+	"os"
+)
+
+// 从归档文件中恢复消息，例如：restore -file ./archive/message_000_20201018150405.jsonl.gz -user_id 1
+func main() {
+	file := flag.String("file", "", "归档文件路径")
+	userId := flag.Int64("user_id", 0, "恢复的用户id，为0时恢复归档文件中的所有消息")
+	flag.Parse()
+	if *file == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	logger.Init()
+	db.InitMysql(config.Logic.MySQL)
+	db.InitRedis(config.Logic.RedisIP, config.Logic.RedisPassword)
+	err := repo.MessageShardRepo.Load()
+	if err != nil {
+		fmt.Println("load message shard error:", err)
+		os.Exit(1)
+	}
+
+	count, err := retention.RetentionService.Restore(context.TODO(), *file, *userId)
+	if err != nil {
+		fmt.Println("restore error:", err)
+		os.Exit(1)
+	}
+	fmt.Println("restored messages:", count)
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 )
 
 var (
@@ -32,11 +33,58 @@ type ConnectConf struct {
 
 // LogicConf logic配置
 type LogicConf struct {
-	MySQL         string
-	NSQIP         string
-	RedisIP       string
-	RedisPassword string
-	RPCListenAddr string
+	MySQL                 string
+	NSQIP                 string
+	RedisIP               string
+	RedisPassword         string
+	RPCListenAddr         string
+	MessageRecallDuration time.Duration // 消息可撤回时长
+	MessageDedupDuration  time.Duration // 消息去重的时间窗口
+	MessageEditDuration   time.Duration // 消息可编辑时长
+	ScheduleInterval      time.Duration // 定时消息的扫描间隔
+	ExpireInterval        time.Duration // 阅后即焚消息的扫描间隔
+	SignalRateLimit       int64         // 每个用户每秒最多发送的瞬时信号数量
+
+	MessageTableNum            int           // 消息表的分表数量，只在第一次启动时保存到数据库，之后以数据库中的分表配置为准
+	MessageShardReloadInterval time.Duration // 从数据库重新加载分表配置的间隔
+
+	SensitiveWords              []string      // 敏感词，和数据库中的敏感词一起使用
+	SensitiveWordReject         bool          // true:拒绝发送包含敏感词的消息，false:屏蔽敏感词后发送
+	SensitiveWordReloadInterval time.Duration // 从数据库重新加载敏感词的间隔
+	BlockedLinkHosts            []string      // 禁止在消息中出现的链接域名，包括子域名
+	FileURLHosts                []string      // 图片、文件消息允许使用的URL域名，包括子域名，为空时不限制
+
+	BeforeSendMessageTypes    []int32       // 用户发送这些类型的消息时回调业务服务，为空时不回调
+	BeforeSendMessageTimeout  time.Duration // 回调业务服务的超时时间
+	BeforeSendMessageFailOpen bool          // 回调失败时，true:放行，false:拒绝发送
+
+	WebhookEndpoints   []WebhookEndpoint // 事件推送的接收地址
+	WebhookInterval    time.Duration     // 扫描待投递事件的间隔
+	WebhookTimeout     time.Duration     // 单次投递的超时时间
+	WebhookMaxAttempts int               // 最大投递次数，超过后记录为死信
+	WebhookMinBackoff  time.Duration     // 第一次重试的间隔，之后每次翻倍
+	WebhookMaxBackoff  time.Duration     // 重试间隔的上限
+	WebhookRetention   time.Duration     // 投递成功的记录的保留时长，超过后删除
+
+	RetentionPolicies   []RetentionPolicy // 消息保留策略，为空时消息永久保留
+	RetentionInterval   time.Duration     // 执行保留策略的间隔
+	RetentionBatchSize  int64             // 每次从一张消息表中清理的最大消息数量
+	RetentionArchiveDir string            // 归档文件的存放目录
+}
+
+// WebhookEndpoint 事件推送的接收地址
+type WebhookEndpoint struct {
+	Url    string   // 接收地址
+	Secret string   // 签名秘钥
+	Events []string // 订阅的事件类型，为空时订阅全部事件
+}
+
+// RetentionPolicy 消息保留策略，超过保留时长的消息会从消息表中清理
+type RetentionPolicy struct {
+	ReceiverType int32         // 接收者类型，pb.ReceiverType
+	MessageType  int32         // 消息类型，pb.MessageType，为0时适用于没有单独配置策略的所有消息类型
+	Duration     time.Duration // 保留时长，按照消息发送时间计算
+	Archive      bool          // 清理前是否归档到文件，归档的消息可以通过restore工具恢复
 }
 
 // BusinessConf Business配置
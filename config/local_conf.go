@@ -2,6 +2,7 @@ package config
 
 import (
 	"gim/pkg/logger"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -25,11 +26,36 @@ func initLocalConf() {
 	}
 
 	Logic = LogicConf{
-		MySQL:         "root:gim123456@tcp(111.229.238.28:3306)/gim?charset=utf8&parseTime=true",
-		NSQIP:         "111.229.238.28:4150",
-		RedisIP:       "111.229.238.28:6379",
-		RedisPassword: "alber123456",
-		RPCListenAddr: ":50100",
+		MySQL:                 "root:gim123456@tcp(111.229.238.28:3306)/gim?charset=utf8&parseTime=true",
+		NSQIP:                 "111.229.238.28:4150",
+		RedisIP:               "111.229.238.28:6379",
+		RedisPassword:         "alber123456",
+		RPCListenAddr:         ":50100",
+		MessageRecallDuration: 2 * time.Minute,
+		MessageDedupDuration:  time.Hour,
+		MessageEditDuration:   15 * time.Minute,
+		ScheduleInterval:      time.Second,
+		ExpireInterval:        time.Second,
+		SignalRateLimit:       10,
+
+		MessageTableNum:            1,
+		MessageShardReloadInterval: 5 * time.Second,
+
+		SensitiveWordReloadInterval: time.Minute,
+
+		BeforeSendMessageTimeout:  500 * time.Millisecond,
+		BeforeSendMessageFailOpen: true,
+
+		WebhookInterval:    time.Second,
+		WebhookTimeout:     5 * time.Second,
+		WebhookMaxAttempts: 10,
+		WebhookMinBackoff:  10 * time.Second,
+		WebhookMaxBackoff:  time.Hour,
+		WebhookRetention:   7 * 24 * time.Hour,
+
+		RetentionInterval:   time.Hour,
+		RetentionBatchSize:  500,
+		RetentionArchiveDir: "./archive",
 	}
 
 	Business = BusinessConf{
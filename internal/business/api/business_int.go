@@ -26,3 +26,8 @@ func (*BusinessIntServer) GetUsers(ctx context.Context, req *pb.GetUsersReq) (*p
 	users, err := app.UserApp.GetByIds(ctx, userIds)
 	return &pb.GetUsersResp{Users: users}, err
 }
+
+// BeforeSendMessage 消息发送前回调，默认全部放行，业务方可以在这里实现付费聊天、拉黑等限制
+func (*BusinessIntServer) BeforeSendMessage(ctx context.Context, req *pb.BeforeSendMessageReq) (*pb.BeforeSendMessageResp, error) {
+	return &pb.BeforeSendMessageResp{}, nil
+}
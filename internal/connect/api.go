@@ -30,3 +30,24 @@ func (s *ConnIntServer) DeliverMessage(ctx context.Context, req *pb.DeliverMessa
 	conn.Send(pb.PackageType_PT_MESSAGE, grpclib.GetCtxRequestId(ctx), req.MessageSend, nil)
 	return resp, nil
 }
+
+// DeliverMessages 批量投递消息，单个设备投递失败不影响其他设备
+func (s *ConnIntServer) DeliverMessages(ctx context.Context, req *pb.DeliverMessagesReq) (*pb.Empty, error) {
+	for _, message := range req.Messages {
+		_, _ = s.DeliverMessage(ctx, message)
+	}
+	return &pb.Empty{}, nil
+}
+
+// DeliverSignal 投递瞬时信号
+func (s *ConnIntServer) DeliverSignal(ctx context.Context, req *pb.DeliverSignalReq) (*pb.Empty, error) {
+	resp := &pb.Empty{}
+
+	conn := GetConn(req.DeviceId)
+	if conn == nil || conn.DeviceId != req.DeviceId {
+		return resp, nil
+	}
+
+	conn.Send(pb.PackageType_PT_SIGNAL, grpclib.GetCtxRequestId(ctx), req.Signal, nil)
+	return resp, nil
+}
@@ -4,6 +4,7 @@ import (
 	"container/list"
 	"context"
 	"gim/config"
+	"gim/pkg/gerrors"
 	"gim/pkg/grpclib"
 	"gim/pkg/logger"
 	"gim/pkg/pb"
@@ -32,6 +33,7 @@ type Conn struct {
 	WS       *websocket.Conn // websocket连接
 	UserId   int64           // 用户ID
 	DeviceId int64           // 设备ID
+	Token    string          // 登录秘钥，通过长连接调用接口时使用
 	RoomId   int64           // 订阅的房间ID
 	Element  *list.Element   // 链表节点
 }
@@ -125,6 +127,12 @@ func (c *Conn) HandleMessage(bytes []byte) {
 		c.MessageACK(input)
 	case pb.PackageType_PT_SUBSCRIBE_ROOM:
 		c.SubscribedRoom(input)
+	case pb.PackageType_PT_SIGNAL:
+		c.Signal(input)
+	case pb.PackageType_PT_SEND_MESSAGE:
+		c.SendMessage(input)
+	case pb.PackageType_PT_RPC:
+		c.RPC(input)
 	default:
 		logger.Logger.Error("handler switch other")
 	}
@@ -192,6 +200,7 @@ func (c *Conn) SignIn(input *pb.Input) {
 
 	c.UserId = signIn.UserId
 	c.DeviceId = signIn.DeviceId
+	c.Token = signIn.Token
 	// 在全局的ConnsManager中保存连接实例
 	SetConn(signIn.DeviceId, c)
 }
@@ -271,3 +280,45 @@ func (c *Conn) SubscribedRoom(input *pb.Input) {
 		logger.Logger.Error("SubscribedRoom error", zap.Error(err))
 	}
 }
+
+// Signal 发送瞬时信号
+func (c *Conn) Signal(input *pb.Input) {
+	var signal pb.SignalInput
+	err := proto.Unmarshal(input.Data, &signal)
+	if err != nil {
+		logger.Sugar.Error(err)
+		return
+	}
+
+	// 信号发给logic服务转发，不需要持久化
+	_, err = rpc.LogicIntClient.Signal(grpclib.ContextWithRequestId(context.TODO(), input.RequestId), &pb.SignalReq{
+		UserId:   c.UserId,
+		DeviceId: c.DeviceId,
+		Signal:   &signal,
+	})
+	c.Send(pb.PackageType_PT_SIGNAL, input.RequestId, nil, err)
+}
+
+// SendMessage 发送消息
+func (c *Conn) SendMessage(input *pb.Input) {
+	var req pb.SendMessageReq
+	err := proto.Unmarshal(input.Data, &req)
+	if err != nil {
+		logger.Sugar.Error(err)
+		c.Send(pb.PackageType_PT_SEND_MESSAGE, input.RequestId, nil, gerrors.ErrBadRequest)
+		return
+	}
+
+	// 使用连接登录的用户和设备作为发送者，客户端通过request_id对应请求和响应
+	resp, err := rpc.LogicIntClient.ConnSendMessage(grpclib.ContextWithRequestId(context.TODO(), input.RequestId), &pb.ConnSendMessageReq{
+		UserId:   c.UserId,
+		DeviceId: c.DeviceId,
+		Message:  &req,
+	})
+
+	var message proto.Message
+	if err == nil {
+		message = resp
+	}
+	c.Send(pb.PackageType_PT_SEND_MESSAGE, input.RequestId, message, err)
+}
@@ -0,0 +1,136 @@
+package connect
+
+import (
+	"context"
+	"gim/pkg/gerrors"
+	"gim/pkg/grpclib"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/rpc"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// RPCWhitelist 允许客户端通过长连接调用的接口
+var RPCWhitelist = map[string]int{
+	"/pb.LogicExt/SendMessage":            0,
+	"/pb.LogicExt/ForwardMessages":        0,
+	"/pb.LogicExt/GetScheduledMessages":   0,
+	"/pb.LogicExt/CancelScheduledMessage": 0,
+	"/pb.LogicExt/RecallMessage":          0,
+	"/pb.LogicExt/EditMessage":            0,
+	"/pb.LogicExt/DeleteMessages":         0,
+	"/pb.LogicExt/ClearConversation":      0,
+	"/pb.LogicExt/AddReaction":            0,
+	"/pb.LogicExt/RemoveReaction":         0,
+	"/pb.LogicExt/MarkRead":               0,
+	"/pb.LogicExt/GetUnreadCount":         0,
+	"/pb.LogicExt/GetHistory":             0,
+	"/pb.LogicExt/GetThread":              0,
+	"/pb.LogicExt/GetMentions":            0,
+	"/pb.LogicExt/SearchMessages":         0,
+	"/pb.LogicExt/GetConversations":       0,
+	"/pb.LogicExt/SetConversation":        0,
+	"/pb.LogicExt/AddFriend":              0,
+	"/pb.LogicExt/AgreeAddFriend":         0,
+	"/pb.LogicExt/SetFriend":              0,
+	"/pb.LogicExt/GetFriends":             0,
+	"/pb.LogicExt/CreateGroup":            0,
+	"/pb.LogicExt/UpdateGroup":            0,
+	"/pb.LogicExt/GetGroup":               0,
+	"/pb.LogicExt/GetGroups":              0,
+	"/pb.LogicExt/AddGroupMembers":        0,
+	"/pb.LogicExt/UpdateGroupMember":      0,
+	"/pb.LogicExt/DeleteGroupMember":      0,
+	"/pb.LogicExt/GetGroupMembers":        0,
+	"/pb.BusinessExt/GetUser":             0,
+	"/pb.BusinessExt/UpdateUser":          0,
+	"/pb.BusinessExt/SearchUser":          0,
+}
+
+// getRPCConn 获取服务对应的GRPC连接
+func getRPCConn(service string) *grpc.ClientConn {
+	switch service {
+	case "pb.LogicExt":
+		return rpc.LogicExtConn
+	case "pb.BusinessExt":
+		return rpc.BusinessExtConn
+	default:
+		return nil
+	}
+}
+
+// newRPCMessages 根据完整方法名，例如/pb.LogicExt/GetFriends，创建接口的请求和响应
+func newRPCMessages(method string) (string, proto.Message, proto.Message, error) {
+	names := strings.Split(method, "/")
+	if len(names) != 3 || names[0] != "" {
+		return "", nil, nil, gerrors.ErrBadRequest
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(names[1]))
+	if err != nil {
+		return "", nil, nil, gerrors.ErrBadRequest
+	}
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return "", nil, nil, gerrors.ErrBadRequest
+	}
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(names[2]))
+	if methodDesc == nil || methodDesc.IsStreamingClient() || methodDesc.IsStreamingServer() {
+		return "", nil, nil, gerrors.ErrBadRequest
+	}
+
+	reqType, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Input().FullName())
+	if err != nil {
+		return "", nil, nil, gerrors.WrapError(err)
+	}
+	respType, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Output().FullName())
+	if err != nil {
+		return "", nil, nil, gerrors.WrapError(err)
+	}
+	return names[1], reqType.New().Interface(), respType.New().Interface(), nil
+}
+
+// RPC 通过长连接调用接口，以连接登录的用户和设备的身份转发给对应的服务
+func (c *Conn) RPC(input *pb.Input) {
+	var rpcInput pb.RPCInput
+	err := proto.Unmarshal(input.Data, &rpcInput)
+	if err != nil {
+		logger.Sugar.Error(err)
+		return
+	}
+
+	resp, err := c.invoke(input.RequestId, &rpcInput)
+	c.Send(pb.PackageType_PT_RPC, input.RequestId, resp, err)
+}
+
+func (c *Conn) invoke(requestId int64, input *pb.RPCInput) (proto.Message, error) {
+	if _, ok := RPCWhitelist[input.Method]; !ok {
+		return nil, gerrors.ErrBadRequest
+	}
+
+	service, req, resp, err := newRPCMessages(input.Method)
+	if err != nil {
+		return nil, err
+	}
+	conn := getRPCConn(service)
+	if conn == nil {
+		return nil, gerrors.ErrBadRequest
+	}
+
+	err = proto.Unmarshal(input.Request, req)
+	if err != nil {
+		return nil, gerrors.ErrBadRequest
+	}
+
+	ctx := grpclib.ContextWithUserData(context.TODO(), requestId, c.UserId, c.DeviceId, c.Token)
+	err = conn.Invoke(ctx, input.Method, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package connect
+
+import (
+	"gim/pkg/pb"
+	"testing"
+)
+
+func Test_newRPCMessages(t *testing.T) {
+	service, req, resp, err := newRPCMessages("/pb.LogicExt/GetGroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service != "pb.LogicExt" {
+		t.Fatalf("service = %s", service)
+	}
+	if _, ok := req.(*pb.GetGroupReq); !ok {
+		t.Fatalf("req type = %T", req)
+	}
+	if _, ok := resp.(*pb.GetGroupResp); !ok {
+		t.Fatalf("resp type = %T", resp)
+	}
+
+	for _, method := range []string{"", "pb.LogicExt/GetGroup", "/pb.LogicExt/NotExist", "/pb.NotExist/GetGroup", "/pb.Message/GetGroup"} {
+		_, _, _, err = newRPCMessages(method)
+		if err == nil {
+			t.Fatalf("method %s should fail", method)
+		}
+	}
+}
+
+func TestRPCWhitelist(t *testing.T) {
+	for method := range RPCWhitelist {
+		service, _, _, err := newRPCMessages(method)
+		if err != nil {
+			t.Fatalf("method %s: %v", method, err)
+		}
+		if service != "pb.LogicExt" && service != "pb.BusinessExt" {
+			t.Fatalf("method %s: unexpected service %s", method, service)
+		}
+	}
+}
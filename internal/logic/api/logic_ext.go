@@ -27,11 +27,184 @@ func (*LogicExtServer) SendMessage(ctx context.Context, in *pb.SendMessageReq) (
 		SenderId:   userId,
 		DeviceId:   deviceId,
 	}
-	seq, err := app.MessageApp.SendMessage(ctx, &sender, in)
+	return app.MessageApp.SendMessage(ctx, &sender, in)
+}
+
+// ForwardMessages 转发消息
+func (*LogicExtServer) ForwardMessages(ctx context.Context, in *pb.ForwardMessagesReq) (*pb.ForwardMessagesResp, error) {
+	userId, deviceId, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sender := pb.Sender{
+		SenderType: pb.SenderType_ST_USER,
+		SenderId:   userId,
+		DeviceId:   deviceId,
+	}
+	return app.MessageApp.ForwardMessages(ctx, &sender, in)
+}
+
+// GetScheduledMessages 获取自己等待发送的定时消息
+func (*LogicExtServer) GetScheduledMessages(ctx context.Context, in *pb.Empty) (*pb.GetScheduledMessagesResp, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.SendMessageResp{Seq: seq}, nil
+
+	messages, err := app.ScheduleApp.GetScheduledMessages(ctx, pb.SenderType_ST_USER, userId)
+	return &pb.GetScheduledMessagesResp{Messages: messages}, err
+}
+
+// CancelScheduledMessage 取消定时消息
+func (*LogicExtServer) CancelScheduledMessage(ctx context.Context, in *pb.CancelScheduledMessageReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.ScheduleApp.CancelScheduledMessage(ctx, pb.SenderType_ST_USER, userId, in.ScheduleId)
+}
+
+// RecallMessage 撤回消息
+func (*LogicExtServer) RecallMessage(ctx context.Context, in *pb.RecallMessageReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.MessageApp.RecallMessage(ctx, userId, in)
+}
+
+// EditMessage 编辑文本消息
+func (*LogicExtServer) EditMessage(ctx context.Context, in *pb.EditMessageReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.MessageApp.EditMessage(ctx, userId, in)
+}
+
+// DeleteMessages 从自己的消息列表中删除消息
+func (*LogicExtServer) DeleteMessages(ctx context.Context, in *pb.DeleteMessagesReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.MessageApp.DeleteMessages(ctx, userId, in)
+}
+
+// ClearConversation 清空自己消息列表中会话的所有消息
+func (*LogicExtServer) ClearConversation(ctx context.Context, in *pb.ClearConversationReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.MessageApp.ClearConversation(ctx, userId, in)
+}
+
+// AddReaction 添加表情回应
+func (*LogicExtServer) AddReaction(ctx context.Context, in *pb.AddReactionReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.MessageApp.AddReaction(ctx, userId, in)
+}
+
+// RemoveReaction 取消表情回应
+func (*LogicExtServer) RemoveReaction(ctx context.Context, in *pb.RemoveReactionReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.MessageApp.RemoveReaction(ctx, userId, in)
+}
+
+// MarkRead 设置会话已读位置
+func (*LogicExtServer) MarkRead(ctx context.Context, in *pb.MarkReadReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.MessageApp.MarkRead(ctx, userId, in)
+}
+
+// GetUnreadCount 获取会话未读数
+func (*LogicExtServer) GetUnreadCount(ctx context.Context, in *pb.GetUnreadCountReq) (*pb.GetUnreadCountResp, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.MessageApp.GetUnreadCount(ctx, userId, in)
+}
+
+// GetHistory 获取会话的历史消息
+func (*LogicExtServer) GetHistory(ctx context.Context, in *pb.GetHistoryReq) (*pb.GetHistoryResp, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.MessageApp.GetHistory(ctx, userId, in)
+}
+
+// GetThread 获取群组话题的回复列表
+func (*LogicExtServer) GetThread(ctx context.Context, in *pb.GetThreadReq) (*pb.GetThreadResp, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.MessageApp.GetThread(ctx, userId, in)
+}
+
+// GetMentions 获取@我的消息
+func (*LogicExtServer) GetMentions(ctx context.Context, in *pb.GetMentionsReq) (*pb.GetMentionsResp, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.MessageApp.GetMentions(ctx, userId, in)
+}
+
+// SearchMessages 搜索文本消息
+func (*LogicExtServer) SearchMessages(ctx context.Context, in *pb.SearchMessagesReq) (*pb.SearchMessagesResp, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := app.MessageApp.SearchMessages(ctx, userId, in)
+	return &pb.SearchMessagesResp{Messages: messages}, err
+}
+
+// GetConversations 分页获取会话列表
+func (*LogicExtServer) GetConversations(ctx context.Context, in *pb.GetConversationsReq) (*pb.GetConversationsResp, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.ConversationApp.GetConversations(ctx, userId, in)
+}
+
+// SetConversation 设置会话
+func (*LogicExtServer) SetConversation(ctx context.Context, in *pb.SetConversationReq) (*pb.Empty, error) {
+	userId, _, err := grpclib.GetCtxData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, app.ConversationApp.SetConversation(ctx, userId, in)
 }
 
 // PushRoom  推送房间
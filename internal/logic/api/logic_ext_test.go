@@ -104,6 +104,196 @@ func TestLogicExtServer_SendImageMessage(t *testing.T) {
 	fmt.Printf("%+v\n", resp)
 }
 
+func TestLogicExtServer_GetScheduledMessages(t *testing.T) {
+	resp, err := getLogicExtClient().GetScheduledMessages(getCtx(), &pb.Empty{})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, message := range resp.Messages {
+		fmt.Printf("%+v\n", message)
+	}
+}
+
+func TestLogicExtServer_CancelScheduledMessage(t *testing.T) {
+	resp, err := getLogicExtClient().CancelScheduledMessage(getCtx(),
+		&pb.CancelScheduledMessageReq{
+			ScheduleId: 1,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_ForwardMessages(t *testing.T) {
+	resp, err := getLogicExtClient().ForwardMessages(getCtx(),
+		&pb.ForwardMessagesReq{
+			MessageIds:   []int64{1, 2},
+			ReceiverType: pb.ReceiverType_RT_GROUP,
+			ReceiverId:   2,
+			IsMerge:      true,
+			Title:        "聊天记录",
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_RecallMessage(t *testing.T) {
+	resp, err := getLogicExtClient().RecallMessage(getCtx(),
+		&pb.RecallMessageReq{
+			Seq: 1,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_EditMessage(t *testing.T) {
+	buf, err := proto.Marshal(&pb.Text{
+		Text: "edited",
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	resp, err := getLogicExtClient().EditMessage(getCtx(),
+		&pb.EditMessageReq{
+			Seq:            1,
+			MessageContent: buf,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_DeleteMessages(t *testing.T) {
+	resp, err := getLogicExtClient().DeleteMessages(getCtx(),
+		&pb.DeleteMessagesReq{
+			Seqs: []int64{1, 2},
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_ClearConversation(t *testing.T) {
+	resp, err := getLogicExtClient().ClearConversation(getCtx(),
+		&pb.ClearConversationReq{
+			ReceiverType: pb.ReceiverType_RT_USER,
+			ReceiverId:   2,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_AddReaction(t *testing.T) {
+	resp, err := getLogicExtClient().AddReaction(getCtx(),
+		&pb.AddReactionReq{
+			Seq:   1,
+			Emoji: "👍",
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_RemoveReaction(t *testing.T) {
+	resp, err := getLogicExtClient().RemoveReaction(getCtx(),
+		&pb.RemoveReactionReq{
+			Seq:   1,
+			Emoji: "👍",
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_MarkRead(t *testing.T) {
+	resp, err := getLogicExtClient().MarkRead(getCtx(),
+		&pb.MarkReadReq{
+			ReceiverType: pb.ReceiverType_RT_USER,
+			ReceiverId:   1,
+			Seq:          1,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_GetUnreadCount(t *testing.T) {
+	resp, err := getLogicExtClient().GetUnreadCount(getCtx(),
+		&pb.GetUnreadCountReq{
+			ReceiverType: pb.ReceiverType_RT_USER,
+			ReceiverId:   1,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
+func TestLogicExtServer_GetThread(t *testing.T) {
+	resp, err := getLogicExtClient().GetThread(getCtx(),
+		&pb.GetThreadReq{
+			GroupId:       2,
+			RootMessageId: 1,
+			Limit:         20,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp.Root)
+	for _, message := range resp.Replies {
+		fmt.Printf("%+v\n", message)
+	}
+}
+
+func TestLogicExtServer_SearchMessages(t *testing.T) {
+	resp, err := getLogicExtClient().SearchMessages(getCtx(),
+		&pb.SearchMessagesReq{
+			Keyword: "hello",
+			Limit:   20,
+		})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, message := range resp.Messages {
+		fmt.Printf("%+v\n", message)
+	}
+}
+
+func TestLogicExtServer_GetConversations(t *testing.T) {
+	resp, err := getLogicExtClient().GetConversations(getCtx(), &pb.GetConversationsReq{Limit: 20})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%+v\n", resp)
+}
+
 func TestLogicExtServer_CreateGroup(t *testing.T) {
 	resp, err := getLogicExtClient().CreateGroup(getCtx(),
 		&pb.CreateGroupReq{
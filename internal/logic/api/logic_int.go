@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"gim/internal/logic/app"
+	"gim/pkg/gerrors"
 	"gim/pkg/logger"
 	"gim/pkg/pb"
 )
@@ -34,6 +35,20 @@ func (s *LogicIntServer) SubscribeRoom(ctx context.Context, req *pb.SubscribeRoo
 	return &pb.Empty{}, app.RoomApp.SubscribeRoom(ctx, req)
 }
 
+// ConnSendMessage 设备通过长连接发送消息
+func (*LogicIntServer) ConnSendMessage(ctx context.Context, req *pb.ConnSendMessageReq) (*pb.SendMessageResp, error) {
+	if req.Message == nil {
+		return nil, gerrors.ErrBadRequest
+	}
+
+	sender := pb.Sender{
+		SenderType: pb.SenderType_ST_USER,
+		SenderId:   req.UserId,
+		DeviceId:   req.DeviceId,
+	}
+	return app.MessageApp.SendMessage(ctx, &sender, req.Message)
+}
+
 // SendMessage 发送消息
 func (*LogicIntServer) SendMessage(ctx context.Context, req *pb.SendMessageReq) (*pb.SendMessageResp, error) {
 	sender := pb.Sender{
@@ -42,11 +57,12 @@ func (*LogicIntServer) SendMessage(ctx context.Context, req *pb.SendMessageReq)
 		DeviceId:   0,
 	}
 
-	seq, err := app.MessageApp.SendMessage(ctx, &sender, req)
-	if err != nil {
-		return nil, err
-	}
-	return &pb.SendMessageResp{Seq: seq}, nil
+	return app.MessageApp.SendMessage(ctx, &sender, req)
+}
+
+// Signal 转发瞬时信号
+func (*LogicIntServer) Signal(ctx context.Context, req *pb.SignalReq) (*pb.Empty, error) {
+	return &pb.Empty{}, app.MessageApp.Signal(ctx, req.UserId, req.DeviceId, req.Signal)
 }
 
 // PushRoom 推送房间
@@ -56,6 +72,17 @@ func (s *LogicIntServer) PushRoom(ctx context.Context, req *pb.PushRoomReq) (*pb
 	}, req)
 }
 
+// GetScheduledMessages 获取业务方等待发送的定时消息
+func (*LogicIntServer) GetScheduledMessages(ctx context.Context, in *pb.Empty) (*pb.GetScheduledMessagesResp, error) {
+	messages, err := app.ScheduleApp.GetScheduledMessages(ctx, pb.SenderType_ST_BUSINESS, 0)
+	return &pb.GetScheduledMessagesResp{Messages: messages}, err
+}
+
+// CancelScheduledMessage 取消业务方的定时消息
+func (*LogicIntServer) CancelScheduledMessage(ctx context.Context, in *pb.CancelScheduledMessageReq) (*pb.Empty, error) {
+	return &pb.Empty{}, app.ScheduleApp.CancelScheduledMessage(ctx, pb.SenderType_ST_BUSINESS, 0, in.ScheduleId)
+}
+
 // PushAll 全服推送
 func (s *LogicIntServer) PushAll(ctx context.Context, req *pb.PushAllReq) (*pb.Empty, error) {
 	return &pb.Empty{}, app.MessageApp.PushAll(ctx, req)
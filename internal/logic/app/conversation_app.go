@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	"gim/internal/logic/domain/conversation"
+	"gim/internal/logic/domain/message/service"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+)
+
+type conversationApp struct{}
+
+var ConversationApp = new(conversationApp)
+
+// GetConversations 分页获取会话列表，每个会话带上最后一条消息
+func (*conversationApp) GetConversations(ctx context.Context, userId int64, req *pb.GetConversationsReq) (*pb.GetConversationsResp, error) {
+	conversations, hasMore, err := conversation.ConversationService.List(ctx, userId, req.Cursor, int64(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	seqs := make([]int64, 0, len(conversations))
+	for i := range conversations {
+		if conversations[i].LastSeq != 0 {
+			seqs = append(seqs, conversations[i].LastSeq)
+		}
+	}
+	messages, err := service.MessageService.ListBySeqs(ctx, userId, seqs)
+	if err != nil {
+		return nil, err
+	}
+	messageMap := make(map[int64]*pb.Message, len(messages))
+	for i := range messages {
+		messageMap[messages[i].Seq] = messages[i]
+	}
+
+	resp := &pb.GetConversationsResp{
+		Conversations: make([]*pb.Conversation, len(conversations)),
+		HasMore:       hasMore,
+	}
+	for i := range conversations {
+		resp.Conversations[i] = conversations[i].ToProto(messageMap[conversations[i].LastSeq])
+	}
+	if len(conversations) > 0 {
+		resp.NextCursor = conversations[len(conversations)-1].Cursor()
+	}
+	return resp, nil
+}
+
+// SetConversation 设置会话
+func (*conversationApp) SetConversation(ctx context.Context, userId int64, req *pb.SetConversationReq) error {
+	if req.ReceiverType != pb.ReceiverType_RT_USER && req.ReceiverType != pb.ReceiverType_RT_GROUP {
+		return gerrors.ErrBadRequest
+	}
+	if req.Ttl < 0 {
+		return gerrors.ErrBadRequest
+	}
+	return conversation.ConversationService.Set(ctx, userId, req)
+}
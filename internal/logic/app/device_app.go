@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	devicedomain "gim/internal/logic/domain/device"
+	"gim/internal/logic/domain/webhook"
 	"gim/pkg/gerrors"
 	"gim/pkg/pb"
 )
@@ -37,7 +38,13 @@ func (*deviceApp) Register(ctx context.Context, in *pb.RegisterDeviceReq) (int64
 
 // SignIn 登录
 func (*deviceApp) SignIn(ctx context.Context, userId, deviceId int64, token string, connAddr string, clientAddr string) error {
-	return devicedomain.DeviceService.SignIn(ctx, userId, deviceId, token, connAddr, clientAddr)
+	err := devicedomain.DeviceService.SignIn(ctx, userId, deviceId, token, connAddr, clientAddr)
+	if err != nil {
+		return err
+	}
+
+	publishDeviceEvent(ctx, webhook.EventDeviceOnline, deviceId, userId, clientAddr)
+	return nil
 }
 
 // Offline 设备离线
@@ -62,6 +69,8 @@ func (*deviceApp) Offline(ctx context.Context, deviceId int64, clientAddr string
 	if err != nil {
 		return err
 	}
+
+	publishDeviceEvent(ctx, webhook.EventDeviceOffline, device.Id, device.UserId, clientAddr)
 	return nil
 }
 
@@ -70,6 +79,11 @@ func (*deviceApp) ListOnlineByUserId(ctx context.Context, userId int64) ([]*pb.D
 	return devicedomain.DeviceService.ListOnlineByUserId(ctx, userId)
 }
 
+// ListOnlineByUserIds 批量获取用户所有在线设备
+func (*deviceApp) ListOnlineByUserIds(ctx context.Context, userIds []int64) (map[int64][]*pb.Device, error) {
+	return devicedomain.DeviceService.ListOnlineByUserIds(ctx, userIds)
+}
+
 // GetDevice 获取设备信息
 func (*deviceApp) GetDevice(ctx context.Context, deviceId int64) (*pb.Device, error) {
 	device, err := devicedomain.DeviceRepo.Get(deviceId)
@@ -85,5 +99,18 @@ func (*deviceApp) GetDevice(ctx context.Context, deviceId int64) (*pb.Device, er
 
 // ServerStop connect服务停止
 func (*deviceApp) ServerStop(ctx context.Context, connAddr string) error {
-	return devicedomain.DeviceService.ServerStop(ctx, connAddr)
+	devices, err := devicedomain.DeviceService.ServerStop(ctx, connAddr)
+	for i := range devices {
+		publishDeviceEvent(ctx, webhook.EventDeviceOffline, devices[i].Id, devices[i].UserId, devices[i].ClientAddr)
+	}
+	return err
+}
+
+// publishDeviceEvent 发布设备上线、离线事件
+func publishDeviceEvent(ctx context.Context, eventType string, deviceId, userId int64, clientAddr string) {
+	webhook.WebhookService.Publish(ctx, eventType, webhook.DeviceData{
+		DeviceId:   deviceId,
+		UserId:     userId,
+		ClientAddr: clientAddr,
+	})
 }
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	frienddomain "gim/internal/logic/domain/friend"
+	"gim/internal/logic/domain/webhook"
 	"gim/pkg/pb"
 	"time"
 )
@@ -23,7 +24,15 @@ func (*friendApp) AddFriend(ctx context.Context, userId, friendId int64, remarks
 
 // AgreeAddFriend 同意添加好友
 func (*friendApp) AgreeAddFriend(ctx context.Context, userId, friendId int64, remarks string) error {
-	return frienddomain.FriendService.AgreeAddFriend(ctx, userId, friendId, remarks)
+	added, err := frienddomain.FriendService.AgreeAddFriend(ctx, userId, friendId, remarks)
+	// 好友关系已经建立，后续推送失败也需要发布事件
+	if added {
+		webhook.WebhookService.Publish(ctx, webhook.EventFriendAdded, webhook.FriendAddedData{
+			UserId:   userId,
+			FriendId: friendId,
+		})
+	}
+	return err
 }
 
 // SetFriend 设置好友信息
@@ -48,6 +57,6 @@ func (*friendApp) SetFriend(ctx context.Context, userId int64, req *pb.SetFriend
 }
 
 // SendToFriend 消息发送至好友
-func (*friendApp) SendToFriend(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
-	return frienddomain.FriendService.SendToFriend(ctx, sender, req)
+func (*friendApp) SendToFriend(ctx context.Context, messageId int64, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
+	return frienddomain.FriendService.SendToFriend(ctx, messageId, sender, req)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"gim/internal/logic/domain/group/model"
 	"gim/internal/logic/domain/group/repo"
+	"gim/internal/logic/domain/webhook"
 	"gim/pkg/pb"
 )
 
@@ -20,6 +21,8 @@ func (*groupApp) CreateGroup(ctx context.Context, userId int64, in *pb.CreateGro
 	if err != nil {
 		return 0, err
 	}
+
+	publishGroupEvent(ctx, webhook.EventGroupCreated, group, userId)
 	return group.Id, nil
 }
 
@@ -68,6 +71,7 @@ func (*groupApp) Update(ctx context.Context, userId int64, update *pb.UpdateGrou
 	if err != nil {
 		return err
 	}
+	publishGroupEvent(ctx, webhook.EventGroupUpdated, group, userId)
 
 	// 向群组内推送群组更新的消息
 	err = group.PushUpdate(ctx, userId)
@@ -93,6 +97,7 @@ func (*groupApp) AddMembers(ctx context.Context, userId, groupId int64, userIds
 	if err != nil {
 		return nil, err
 	}
+	publishGroupMemberEvent(ctx, webhook.EventGroupMemberAdded, group.Id, userId, addedIds)
 
 	// 向群组内推送添加成员的消息
 	err = group.PushAddMember(ctx, userId, addedIds)
@@ -137,6 +142,7 @@ func (*groupApp) DeleteMember(ctx context.Context, groupId int64, userId int64,
 	if err != nil {
 		return err
 	}
+	publishGroupMemberEvent(ctx, webhook.EventGroupMemberRemoved, group.Id, optId, []int64{userId})
 
 	// 向group推送移除成员的消息
 	err = group.PushDeleteMember(ctx, optId, userId)
@@ -157,12 +163,36 @@ func (*groupApp) GetMembers(ctx context.Context, groupId int64) ([]*pb.GroupMemb
 }
 
 // SendMessage 向群组发送消息
-func (*groupApp) SendMessage(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
+func (*groupApp) SendMessage(ctx context.Context, messageId int64, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
 	group, err := repo.GroupRepo.Get(req.ReceiverId)
 	if err != nil {
 		return 0, err
 	}
 
 	// 向group中推送消息
-	return group.SendMessage(ctx, sender, req)
+	return group.SendMessage(ctx, messageId, sender, req)
+}
+
+// publishGroupEvent 发布群组创建、更新事件
+func publishGroupEvent(ctx context.Context, eventType string, group *model.Group, optId int64) {
+	webhook.WebhookService.Publish(ctx, eventType, webhook.GroupData{
+		GroupId:      group.Id,
+		OptId:        optId,
+		Name:         group.Name,
+		AvatarUrl:    group.AvatarUrl,
+		Introduction: group.Introduction,
+		Extra:        group.Extra,
+	})
+}
+
+// publishGroupMemberEvent 发布群组成员添加、移除事件
+func publishGroupMemberEvent(ctx context.Context, eventType string, groupId, optId int64, userIds []int64) {
+	if len(userIds) == 0 {
+		return
+	}
+	webhook.WebhookService.Publish(ctx, eventType, webhook.GroupMemberData{
+		GroupId: groupId,
+		OptId:   optId,
+		UserIds: userIds,
+	})
 }
@@ -2,9 +2,24 @@ package app
 
 import (
 	"context"
+	"gim/config"
+	"gim/internal/logic/domain/conversation"
+	"gim/internal/logic/domain/friend"
+	grouprepo "gim/internal/logic/domain/group/repo"
+	"gim/internal/logic/domain/message/model"
 	"gim/internal/logic/domain/message/service"
+	"gim/internal/logic/domain/message/validator"
+	"gim/internal/logic/domain/moderation"
+	"gim/internal/logic/domain/schedule"
+	"gim/internal/logic/domain/webhook"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
 	"gim/pkg/pb"
+	"gim/pkg/util"
+	"strings"
+	"time"
 
+	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -12,9 +27,19 @@ type messageApp struct{}
 
 var MessageApp = new(messageApp)
 
+// NewMessageId 生成全局唯一的消息id
+func (*messageApp) NewMessageId() (int64, error) {
+	return service.MessageService.NewMessageId()
+}
+
 // SendToUser 发送消息给用户
-func (*messageApp) SendToUser(ctx context.Context, sender *pb.Sender, toUserId int64, req *pb.SendMessageReq) (int64, error) {
-	return service.MessageService.SendToUser(ctx, sender, toUserId, req)
+func (*messageApp) SendToUser(ctx context.Context, messageId int64, sender *pb.Sender, toUserId int64, req *pb.SendMessageReq) (int64, error) {
+	return service.MessageService.SendToUser(ctx, messageId, sender, toUserId, req)
+}
+
+// SendToUsers 批量发送消息给多个用户，返回发送失败的用户id
+func (*messageApp) SendToUsers(ctx context.Context, messageId int64, sender *pb.Sender, toUserIds []int64, req *pb.SendMessageReq) []int64 {
+	return service.MessageService.SendToUsers(ctx, messageId, sender, toUserIds, req)
 }
 
 // PushToUser 推送消息给用户，Push一般推送的是系统消息
@@ -27,6 +52,23 @@ func (*messageApp) PushAll(ctx context.Context, req *pb.PushAllReq) error {
 	return service.PushService.PushAll(ctx, req)
 }
 
+// StartExpire 启动阅后即焚消息的销毁任务
+func (*messageApp) StartExpire() {
+	go func() {
+		ticker := time.NewTicker(config.Logic.ExpireInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			func() {
+				defer util.RecoverPanic()
+				err := service.MessageExpireService.Expire(context.TODO())
+				if err != nil {
+					logger.Logger.Error("expire messages error", zap.Error(err))
+				}
+			}()
+		}
+	}()
+}
+
 // Sync 消息同步
 func (*messageApp) Sync(ctx context.Context, userId, seq int64) (*pb.SyncResp, error) {
 	return service.MessageService.Sync(ctx, userId, seq)
@@ -38,24 +80,391 @@ func (*messageApp) MessageAck(ctx context.Context, userId, deviceId, ack int64)
 	return service.DeviceAckService.Update(ctx, userId, deviceId, ack)
 }
 
-// SendMessage 发送消息
-func (s *messageApp) SendMessage(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
+// SendMessage 发送消息，如果客户端带了消息唯一标识，在去重时间窗口内重复发送会返回第一次发送的结果
+func (s *messageApp) SendMessage(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) (*pb.SendMessageResp, error) {
+	// 合并转发等消息只能由服务端生成，用户不能直接发送
+	if sender.SenderType == pb.SenderType_ST_USER {
+		err := validator.ValidateUserType(req.MessageType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.ClientMessageKey == "" {
+		resp, err := s.sendMessage(ctx, sender, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	resp, err := service.MessageDedupService.Begin(ctx, sender, req.ClientMessageKey)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		return resp, nil
+	}
+
+	resp, err = s.sendMessage(ctx, sender, req)
+	if err != nil {
+		// 部分发送失败时（例如发送者的消息已经保存，接收者的消息保存失败）也不保存发送结果，允许客户端重试，
+		// 否则重试时会直接返回成功，接收者永远收不到这条消息
+		service.MessageDedupService.Finish(ctx, sender, req.ClientMessageKey, nil)
+		return nil, err
+	}
+	service.MessageDedupService.Finish(ctx, sender, req.ClientMessageKey, resp)
+	return resp, nil
+}
+
+// sendMessage 发送消息
+func (s *messageApp) sendMessage(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) (*pb.SendMessageResp, error) {
+	// 附加字段只能由业务服务设置，用户发送的消息忽略客户端传入的值，由发送前回调重新设置
+	if sender.SenderType == pb.SenderType_ST_USER {
+		req.Extra = ""
+	}
+	err := validator.ValidateExtra(req.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按照消息类型校验消息内容
+	err = validator.Validate(req.MessageType, req.MessageContent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 内容审核，审核器可能会改写消息内容
+	req.MessageContent, err = moderation.Moderate(ctx, sender, req.MessageType, req.MessageContent)
+	if err != nil {
+		return nil, err
+	}
+
+	// @只在群聊中生效
+	if req.ReceiverType != pb.ReceiverType_RT_GROUP {
+		req.ToUserIds = nil
+		req.IsMentionAll = false
+	}
+
+	// 定时消息先保存起来，到了发送时间再由ScheduleApp发送
+	if req.SendAt > util.UnixMilliTime(time.Now()) {
+		if req.ReceiverType != pb.ReceiverType_RT_USER && req.ReceiverType != pb.ReceiverType_RT_GROUP {
+			return nil, gerrors.ErrBadRequest
+		}
+		scheduleId, err := schedule.ScheduledMessageService.Add(ctx, sender, req)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SendMessageResp{ScheduleId: scheduleId}, nil
+	}
+
+	// 回调业务服务，定时消息在真正发送时才回调
+	err = service.BeforeSendService.Check(ctx, sender, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 引用回复需要填写被引用消息的快照
+	err = service.MessageReplyService.FillReplyTo(ctx, sender, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 没有指定阅后即焚时长时，使用发送者在会话中的默认设置
+	if req.Ttl < 0 {
+		return nil, gerrors.ErrBadRequest
+	}
+	if req.Ttl == 0 && sender.SenderType == pb.SenderType_ST_USER && req.IsPersist {
+		req.Ttl, err = conversation.ConversationService.GetTtl(ctx, sender.SenderId, int32(req.ReceiverType), req.ReceiverId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// 如果发送者是用户，需要补充发送者用户的信息
 	service.MessageService.AddSenderInfo(sender)
 
+	// 生成消息id，同一条消息写扩散的所有副本共用这个id
+	messageId, err := s.NewMessageId()
+	if err != nil {
+		return nil, err
+	}
+
+	var seq int64
 	switch req.ReceiverType {
 	// 消息接收者为用户
 	case pb.ReceiverType_RT_USER:
 		// 发送者为用户，说明是两个好友发送信息
 		if sender.SenderType == pb.SenderType_ST_USER {
-			return FriendApp.SendToFriend(ctx, sender, req)
+			seq, err = FriendApp.SendToFriend(ctx, messageId, sender, req)
 		} else {
 			// 否则可以看做系统推送消息
-			return s.SendToUser(ctx, sender, req.ReceiverId, req)
+			seq, err = s.SendToUser(ctx, messageId, sender, req.ReceiverId, req)
 		}
 	// 消息接收者是群组
 	case pb.ReceiverType_RT_GROUP:
-		return GroupApp.SendMessage(ctx, sender, req)
+		seq, err = GroupApp.SendMessage(ctx, messageId, sender, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	publishMessageSent(ctx, messageId, sender, req)
+	return &pb.SendMessageResp{Seq: seq, MessageId: messageId}, nil
+}
+
+// ForwardMessages 转发消息，消息内容从发送者的消息列表中复制，不使用客户端传来的内容
+func (s *messageApp) ForwardMessages(ctx context.Context, sender *pb.Sender, req *pb.ForwardMessagesReq) (*pb.ForwardMessagesResp, error) {
+	if len(req.MessageIds) == 0 || len(req.MessageIds) > service.MessageLimit {
+		return nil, gerrors.ErrBadRequest
+	}
+	if req.ReceiverType != pb.ReceiverType_RT_USER && req.ReceiverType != pb.ReceiverType_RT_GROUP {
+		return nil, gerrors.ErrBadRequest
+	}
+
+	messages, err := service.MessageForwardService.ListForwardMessages(ctx, sender.SenderId, req.MessageIds)
+	if err != nil {
+		return nil, err
+	}
+
+	sendTime := util.UnixMilliTime(time.Now())
+	if req.IsMerge {
+		content, err := service.MessageForwardService.NewMerge(ctx, req.Title, messages)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.sendMessage(ctx, sender, &pb.SendMessageReq{
+			ReceiverType:   req.ReceiverType,
+			ReceiverId:     req.ReceiverId,
+			MessageType:    pb.MessageType_MT_MERGE,
+			MessageContent: content,
+			SendTime:       sendTime,
+			IsPersist:      true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ForwardMessagesResp{Messages: []*pb.SendMessageResp{resp}}, nil
+	}
+
+	resps := make([]*pb.SendMessageResp, 0, len(messages))
+	for i := range messages {
+		resp, err := s.sendMessage(ctx, sender, &pb.SendMessageReq{
+			ReceiverType:   req.ReceiverType,
+			ReceiverId:     req.ReceiverId,
+			MessageType:    pb.MessageType(messages[i].Type),
+			MessageContent: messages[i].Content,
+			SendTime:       sendTime,
+			IsPersist:      true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resps = append(resps, resp)
+	}
+	return &pb.ForwardMessagesResp{Messages: resps}, nil
+}
+
+// RecallMessage 撤回消息
+func (*messageApp) RecallMessage(ctx context.Context, userId int64, req *pb.RecallMessageReq) error {
+	message, err := service.MessageService.GetRecallMessage(ctx, userId, req.Seq)
+	if err != nil {
+		return err
+	}
+
+	userIds, err := messageUserIds(ctx, message)
+	if err != nil {
+		return err
+	}
+	err = service.MessageService.Recall(ctx, message, userIds)
+	if err != nil {
+		return err
+	}
+
+	webhook.WebhookService.Publish(ctx, webhook.EventMessageRecalled, webhook.MessageRecalledData{
+		MessageId:    message.MessageId,
+		OptId:        message.SenderId,
+		ReceiverType: message.ReceiverType,
+		ReceiverId:   message.ReceiverId,
+		RecallTime:   util.UnixMilliTime(time.Now()),
+	})
+	return nil
+}
+
+// publishMessageSent 发布消息发送事件，阅后即焚消息不包含消息内容，避免内容在消息销毁后保留在投递记录中
+func publishMessageSent(ctx context.Context, messageId int64, sender *pb.Sender, req *pb.SendMessageReq) {
+	content := req.MessageContent
+	if req.Ttl > 0 {
+		content = nil
+	}
+	webhook.WebhookService.Publish(ctx, webhook.EventMessageSent, webhook.MessageSentData{
+		MessageId:      messageId,
+		SenderType:     int32(sender.SenderType),
+		SenderId:       sender.SenderId,
+		ReceiverType:   int32(req.ReceiverType),
+		ReceiverId:     req.ReceiverId,
+		MessageType:    int32(req.MessageType),
+		MessageContent: content,
+		SendTime:       req.SendTime,
+	})
+}
+
+// EditMessage 编辑文本消息
+func (*messageApp) EditMessage(ctx context.Context, userId int64, req *pb.EditMessageReq) error {
+	message, err := service.MessageEditService.GetEditMessage(ctx, userId, req.Seq)
+	if err != nil {
+		return err
+	}
+	err = validator.Validate(pb.MessageType(message.Type), req.MessageContent)
+	if err != nil {
+		return err
+	}
+	content, err := moderation.Moderate(ctx, &pb.Sender{SenderType: pb.SenderType_ST_USER, SenderId: userId},
+		pb.MessageType(message.Type), req.MessageContent)
+	if err != nil {
+		return err
+	}
+
+	userIds, err := messageUserIds(ctx, message)
+	if err != nil {
+		return err
+	}
+	return service.MessageEditService.Edit(ctx, message, content, userIds)
+}
+
+// DeleteMessages 从自己的消息列表中删除消息
+func (*messageApp) DeleteMessages(ctx context.Context, userId int64, req *pb.DeleteMessagesReq) error {
+	if len(req.Seqs) == 0 || len(req.Seqs) > service.MessageLimit {
+		return gerrors.ErrBadRequest
+	}
+	return service.MessageDeleteService.Delete(ctx, userId, req.Seqs)
+}
+
+// ClearConversation 清空自己消息列表中会话的所有消息
+func (*messageApp) ClearConversation(ctx context.Context, userId int64, req *pb.ClearConversationReq) error {
+	if req.ReceiverType != pb.ReceiverType_RT_USER && req.ReceiverType != pb.ReceiverType_RT_GROUP {
+		return gerrors.ErrBadRequest
+	}
+	return service.MessageDeleteService.Clear(ctx, userId, req.ReceiverType, req.ReceiverId)
+}
+
+// AddReaction 添加表情回应
+func (*messageApp) AddReaction(ctx context.Context, userId int64, req *pb.AddReactionReq) error {
+	if req.Emoji == "" || len(req.Emoji) > service.MaxEmojiLen {
+		return gerrors.ErrBadRequest
+	}
+	message, err := service.MessageReactionService.GetMessage(ctx, userId, req.Seq)
+	if err != nil {
+		return err
+	}
+	userIds, err := messageUserIds(ctx, message)
+	if err != nil {
+		return err
+	}
+	return service.MessageReactionService.Add(ctx, userId, message, req.Emoji, userIds)
+}
+
+// RemoveReaction 取消表情回应
+func (*messageApp) RemoveReaction(ctx context.Context, userId int64, req *pb.RemoveReactionReq) error {
+	if req.Emoji == "" || len(req.Emoji) > service.MaxEmojiLen {
+		return gerrors.ErrBadRequest
+	}
+	message, err := service.MessageReactionService.GetMessage(ctx, userId, req.Seq)
+	if err != nil {
+		return err
+	}
+	userIds, err := messageUserIds(ctx, message)
+	if err != nil {
+		return err
+	}
+	return service.MessageReactionService.Remove(ctx, userId, message, req.Emoji, userIds)
+}
+
+// messageUserIds 找出所有持有这条消息副本的用户，群聊不使用当前的群成员，
+// 消息发送之后退出群组的成员仍然持有这条消息，之后加入群组的成员没有这条消息
+func messageUserIds(ctx context.Context, message *model.Message) ([]int64, error) {
+	return service.MessageService.ListUserIds(ctx, message)
+}
+
+// Signal 转发瞬时信号，例如正在输入，只投递给在线设备，不持久化
+func (*messageApp) Signal(ctx context.Context, userId, deviceId int64, signal *pb.SignalInput) error {
+	if signal == nil || len(signal.Data) > validator.MaxCustomLen {
+		return gerrors.ErrBadRequest
+	}
+	err := service.SignalService.CheckRate(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	var userIds []int64
+	switch signal.ReceiverType {
+	case pb.ReceiverType_RT_USER:
+		f, err := friend.FriendRepo.Get(userId, signal.ReceiverId)
+		if err != nil {
+			return err
+		}
+		if f == nil || f.Status != friend.FriendStatusAgree {
+			return gerrors.ErrNotIsFriend
+		}
+		userIds = []int64{signal.ReceiverId}
+	case pb.ReceiverType_RT_GROUP:
+		group, err := grouprepo.GroupRepo.Get(signal.ReceiverId)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return gerrors.ErrGroupNotExist
+		}
+		if !group.IsMember(userId) {
+			return gerrors.ErrNotInGroup
+		}
+		userIds = make([]int64, 0, len(group.Members))
+		for i := range group.Members {
+			userIds = append(userIds, group.Members[i].UserId)
+		}
+	default:
+		return gerrors.ErrBadRequest
+	}
+
+	service.SignalService.Send(ctx, userId, deviceId, userIds, signal)
+	return nil
+}
+
+// MarkRead 设置会话已读位置
+func (*messageApp) MarkRead(ctx context.Context, userId int64, req *pb.MarkReadReq) error {
+	if req.ReceiverType != pb.ReceiverType_RT_USER && req.ReceiverType != pb.ReceiverType_RT_GROUP {
+		return gerrors.ErrBadRequest
+	}
+	return service.MessageReadService.MarkRead(ctx, userId, req.ReceiverType, req.ReceiverId, req.Seq)
+}
+
+// GetUnreadCount 获取会话未读数
+func (*messageApp) GetUnreadCount(ctx context.Context, userId int64, req *pb.GetUnreadCountReq) (*pb.GetUnreadCountResp, error) {
+	return service.MessageReadService.GetUnreadCount(ctx, userId, req.ReceiverType, req.ReceiverId)
+}
+
+// GetHistory 获取会话的历史消息
+func (*messageApp) GetHistory(ctx context.Context, userId int64, req *pb.GetHistoryReq) (*pb.GetHistoryResp, error) {
+	if req.ReceiverType != pb.ReceiverType_RT_USER && req.ReceiverType != pb.ReceiverType_RT_GROUP {
+		return nil, gerrors.ErrBadRequest
+	}
+	return service.MessageService.GetHistory(ctx, userId, req)
+}
+
+// GetThread 获取群组话题的回复列表
+func (*messageApp) GetThread(ctx context.Context, userId int64, req *pb.GetThreadReq) (*pb.GetThreadResp, error) {
+	return service.MessageReplyService.GetThread(ctx, userId, req)
+}
+
+// GetMentions 获取@我的消息
+func (*messageApp) GetMentions(ctx context.Context, userId int64, req *pb.GetMentionsReq) (*pb.GetMentionsResp, error) {
+	return service.MessageService.GetMentions(ctx, userId, req)
+}
+
+// SearchMessages 搜索文本消息
+func (*messageApp) SearchMessages(ctx context.Context, userId int64, req *pb.SearchMessagesReq) ([]*pb.Message, error) {
+	if strings.TrimSpace(req.Keyword) == "" {
+		return nil, gerrors.ErrBadRequest
 	}
-	return 0, nil
+	return service.MessageService.SearchMessages(ctx, userId, req)
 }
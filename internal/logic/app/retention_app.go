@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"gim/config"
+	"gim/internal/logic/domain/retention"
+	"gim/pkg/logger"
+	"gim/pkg/util"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type retentionApp struct{}
+
+var RetentionApp = new(retentionApp)
+
+// Start 定时按照保留策略清理过期的消息，没有配置保留策略时不启动
+func (s *retentionApp) Start() {
+	if len(config.Logic.RetentionPolicies) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(config.Logic.RetentionInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.run(context.TODO())
+		}
+	}()
+}
+
+func (*retentionApp) run(ctx context.Context) {
+	defer util.RecoverPanic()
+
+	err := retention.RetentionService.Run(ctx)
+	if err != nil {
+		logger.Logger.Error("run retention error", zap.Error(err))
+	}
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+	"gim/config"
+	"gim/internal/logic/domain/schedule"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type scheduleApp struct{}
+
+var ScheduleApp = new(scheduleApp)
+
+// Start 启动定时消息的扫描，到了发送时间的定时消息通过MessageApp.SendMessage发送
+func (s *scheduleApp) Start() {
+	go func() {
+		ticker := time.NewTicker(config.Logic.ScheduleInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.fire(context.TODO())
+		}
+	}()
+}
+
+// GetScheduledMessages 获取发送者等待发送的定时消息
+func (*scheduleApp) GetScheduledMessages(ctx context.Context, senderType pb.SenderType, senderId int64) ([]*pb.ScheduledMessage, error) {
+	return schedule.ScheduledMessageService.List(ctx, senderType, senderId)
+}
+
+// CancelScheduledMessage 取消发送者的定时消息
+func (*scheduleApp) CancelScheduledMessage(ctx context.Context, senderType pb.SenderType, senderId, scheduleId int64) error {
+	return schedule.ScheduledMessageService.Cancel(ctx, senderType, senderId, scheduleId)
+}
+
+func (s *scheduleApp) fire(ctx context.Context) {
+	defer util.RecoverPanic()
+
+	messages, err := schedule.ScheduledMessageService.ListDue(ctx)
+	if err != nil {
+		logger.Logger.Error("list due scheduled messages error", zap.Error(err))
+		return
+	}
+	for i := range messages {
+		ok, err := schedule.ScheduledMessageService.Claim(ctx, messages[i].Id)
+		if err != nil {
+			logger.Logger.Error("claim scheduled message error", zap.Int64("schedule_id", messages[i].Id), zap.Error(err))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		s.send(ctx, &messages[i])
+	}
+}
+
+func (*scheduleApp) send(ctx context.Context, message *schedule.ScheduledMessage) {
+	req, err := message.GetRequest()
+	if err != nil {
+		schedule.ScheduledMessageService.Finish(ctx, message.Id, nil, nil, err)
+		return
+	}
+
+	// 使用定时消息id作为去重标识，实例在发送过程中退出后重新发送，不会产生重复的消息
+	req.ClientMessageKey = "schedule:" + strconv.FormatInt(message.Id, 10)
+	req.SendTime = util.UnixMilliTime(time.Now())
+	resp, err := MessageApp.SendMessage(ctx, message.Sender(), req)
+	if err == gerrors.ErrMessageSending {
+		// 其他实例正在发送，超时后再检查发送结果
+		return
+	}
+	// 发送时会使用会话的默认阅后即焚时长，req.Ttl为最终的阅后即焚时长
+	schedule.ScheduledMessageService.Finish(ctx, message.Id, req, resp, err)
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+	"gim/config"
+	"gim/internal/logic/domain/webhook"
+	"gim/pkg/logger"
+	"gim/pkg/util"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type webhookApp struct{}
+
+var WebhookApp = new(webhookApp)
+
+const webhookCleanInterval = time.Hour // 清理投递成功的记录的间隔
+
+// Start 启动webhook事件的投递，投递失败的事件按照指数退避重试，并且定时清理投递成功的记录
+func (*webhookApp) Start() {
+	go func() {
+		ticker := time.NewTicker(config.Logic.WebhookInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			func() {
+				defer util.RecoverPanic()
+				err := webhook.WebhookService.Deliver(context.TODO())
+				if err != nil {
+					logger.Logger.Error("deliver webhook events error", zap.Error(err))
+				}
+			}()
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(webhookCleanInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			func() {
+				defer util.RecoverPanic()
+				count, err := webhook.WebhookService.Clean(context.TODO())
+				if err != nil {
+					logger.Logger.Error("clean webhook deliveries error", zap.Error(err))
+				}
+				if count > 0 {
+					logger.Logger.Info("clean webhook deliveries", zap.Int64("count", count))
+				}
+			}()
+		}
+	}()
+}
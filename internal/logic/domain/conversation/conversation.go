@@ -0,0 +1,111 @@
+package conversation
+
+import (
+	"fmt"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"time"
+)
+
+const ConversationLimit = 100 // 每次获取的最大会话数量
+
+// Conversation 会话
+type Conversation struct {
+	Id            int64     // 自增主键
+	UserId        int64     // 用户id
+	ReceiverType  int32     // 会话类型
+	ReceiverId    int64     // 单聊为对方用户id，群聊为群组id
+	LastSeq       int64     // 最后一条消息的序列号
+	LastMessageId int64     // 最后一条消息的id
+	LastTime      time.Time // 最后一条消息的发送时间
+	UnreadCount   int64     // 未读数
+	IsTop         bool      // 是否置顶
+	IsMute        bool      // 是否免打扰
+	Extra         string    // 附加字段
+	Ttl           int32     // 默认阅后即焚时长，单位秒
+	MentionSeq    int64     // 最新一条未读的@我的消息的序列号，0表示没有
+	CreateTime    time.Time // 创建时间
+	UpdateTime    time.Time // 更新时间
+}
+
+// 会话设置中可以单独更新的字段
+const (
+	SettingIsTop  = "is_top"
+	SettingIsMute = "is_mute"
+	SettingExtra  = "extra"
+	SettingTtl    = "ttl"
+)
+
+// SettingFields 会话设置中所有可以更新的字段
+var SettingFields = map[string]bool{
+	SettingIsTop:  true,
+	SettingIsMute: true,
+	SettingExtra:  true,
+	SettingTtl:    true,
+}
+
+// Setting 用户对会话的设置
+type Setting struct {
+	IsTop  bool   // 是否置顶
+	IsMute bool   // 是否免打扰
+	Extra  string // 附加字段
+	Ttl    int32  // 默认阅后即焚时长，单位秒
+}
+
+// LastMessage 用户收到的一条消息，用来批量更新会话的最后一条消息
+type LastMessage struct {
+	UserId       int64     // 用户id
+	ReceiverType int32     // 会话类型
+	ReceiverId   int64     // 单聊为对方用户id，群聊为群组id
+	Seq          int64     // 消息序列号
+	MessageId    int64     // 消息id
+	SendTime     time.Time // 消息发送时间
+	IsUnread     bool      // 是否计入未读
+	IsMentioned  bool      // 用户是否被@
+}
+
+// ListCursor 会话列表的分页位置，即上一页最后一个会话的排序字段
+type ListCursor struct {
+	IsTop    bool      // 是否置顶
+	LastTime time.Time // 最后一条消息的发送时间
+	Id       int64     // 自增主键
+}
+
+// Cursor 以这个会话作为上一页最后一个会话时的分页位置
+func (c *Conversation) Cursor() string {
+	var isTop int
+	if c.IsTop {
+		isTop = 1
+	}
+	return fmt.Sprintf("%d:%d:%d", isTop, util.UnixMilliTime(c.LastTime), c.Id)
+}
+
+// ParseCursor 解析分页位置，cursor为空时返回nil
+func ParseCursor(cursor string) (*ListCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	var isTop int
+	var lastTime, id int64
+	_, err := fmt.Sscanf(cursor, "%d:%d:%d", &isTop, &lastTime, &id)
+	if err != nil {
+		return nil, gerrors.ErrBadRequest
+	}
+	return &ListCursor{IsTop: isTop == 1, LastTime: util.UnunixMilliTime(lastTime), Id: id}, nil
+}
+
+func (c *Conversation) ToProto(lastMessage *pb.Message) *pb.Conversation {
+	return &pb.Conversation{
+		ReceiverType: pb.ReceiverType(c.ReceiverType),
+		ReceiverId:   c.ReceiverId,
+		LastMessage:  lastMessage,
+		UnreadCount:  c.UnreadCount,
+		IsTop:        c.IsTop,
+		IsMute:       c.IsMute,
+		Extra:        c.Extra,
+		UpdateTime:   util.UnixMilliTime(c.LastTime),
+		Ttl:          c.Ttl,
+		MentionSeq:   c.MentionSeq,
+	}
+}
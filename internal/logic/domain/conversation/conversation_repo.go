@@ -0,0 +1,141 @@
+package conversation
+
+import (
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+type conversationRepo struct{}
+
+var ConversationRepo = new(conversationRepo)
+
+// Get 获取会话，会话不存在返回nil
+func (*conversationRepo) Get(userId int64, receiverType int32, receiverId int64) (*Conversation, error) {
+	var conversation Conversation
+	err := db.DB.First(&conversation, "user_id = ? and receiver_type = ? and receiver_id = ?",
+		userId, receiverType, receiverId).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, gerrors.WrapError(err)
+	}
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &conversation, nil
+}
+
+// List 分页获取用户的会话列表，置顶的会话在前，其余按最后一条消息的时间倒序，cursor为nil时从第一个会话开始获取
+func (*conversationRepo) List(userId int64, cursor *ListCursor, limit int64) ([]Conversation, bool, error) {
+	DB := db.DB.Where("user_id = ?", userId)
+	if cursor != nil {
+		DB = DB.Where("(is_top < ?) or (is_top = ? and last_time < ?) or (is_top = ? and last_time = ? and id < ?)",
+			cursor.IsTop, cursor.IsTop, cursor.LastTime, cursor.IsTop, cursor.LastTime, cursor.Id)
+	}
+
+	// 多查询一条，判断是否还有更多数据
+	var conversations []Conversation
+	err := DB.Order("is_top desc, last_time desc, id desc").Limit(limit + 1).Find(&conversations).Error
+	if err != nil {
+		return nil, false, gerrors.WrapError(err)
+	}
+	if int64(len(conversations)) > limit {
+		return conversations[:limit], true, nil
+	}
+	return conversations, false, nil
+}
+
+// updateLastMessageSQL 会话已经存在时，只有序列号更大的消息才更新最后一条消息，@我的消息序列号同样只会前进，避免并发写入时回退，
+// MySQL按顺序执行赋值，last_seq需要最后更新
+const updateLastMessageSQL = "on duplicate key update " +
+	"last_message_id = if(values(last_seq) > last_seq, values(last_message_id), last_message_id)," +
+	"last_time = if(values(last_seq) > last_seq, values(last_time), last_time)," +
+	"last_seq = if(values(last_seq) > last_seq, values(last_seq), last_seq)," +
+	"unread_count = unread_count + values(unread_count)," +
+	"mention_seq = greatest(mention_seq, values(mention_seq))"
+
+// UpdateLastMessage 更新会话的最后一条消息，会话不存在时创建会话，unreadIncr为增加的未读数，mentionSeq不为0时更新@我的消息序列号
+func (*conversationRepo) UpdateLastMessage(userId int64, receiverType int32, receiverId int64, seq, messageId int64,
+	lastTime time.Time, unreadIncr int64, mentionSeq int64) error {
+	err := db.DB.Exec("insert into conversation (user_id,receiver_type,receiver_id,last_seq,last_message_id,last_time,unread_count,mention_seq) "+
+		"values (?,?,?,?,?,?,?,?) "+updateLastMessageSQL,
+		userId, receiverType, receiverId, seq, messageId, lastTime, unreadIncr, mentionSeq).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// UpdateUnreadCount 更新会话的未读数
+func (*conversationRepo) UpdateUnreadCount(userId int64, receiverType int32, receiverId int64, unreadCount int64) error {
+	err := db.DB.Exec("update conversation set unread_count = ? where user_id = ? and receiver_type = ? and receiver_id = ?",
+		unreadCount, userId, receiverType, receiverId).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// UpdateLastMessages 批量更新会话的最后一条消息，会话不存在时创建会话，规则和UpdateLastMessage相同
+func (*conversationRepo) UpdateLastMessages(messages []LastMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(messages))
+	values := make([]interface{}, 0, 8*len(messages))
+	for i, message := range messages {
+		var unreadIncr, mentionSeq int64
+		if message.IsUnread {
+			unreadIncr = 1
+		}
+		if message.IsMentioned {
+			mentionSeq = message.Seq
+		}
+		placeholders[i] = "(?,?,?,?,?,?,?,?)"
+		values = append(values, message.UserId, message.ReceiverType, message.ReceiverId, message.Seq,
+			message.MessageId, message.SendTime, unreadIncr, mentionSeq)
+	}
+
+	err := db.DB.Exec("insert into conversation (user_id,receiver_type,receiver_id,last_seq,last_message_id,last_time,unread_count,mention_seq) "+
+		"values "+strings.Join(placeholders, ",")+" "+updateLastMessageSQL,
+		values...).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// ClearMention 已读位置到达@我的消息之后，清除会话的@我的消息序列号
+func (*conversationRepo) ClearMention(userId int64, receiverType int32, receiverId int64, readSeq int64) error {
+	err := db.DB.Exec("update conversation set mention_seq = 0 where user_id = ? and receiver_type = ? and receiver_id = ? and mention_seq > 0 and mention_seq <= ?",
+		userId, receiverType, receiverId, readSeq).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// SaveSetting 保存会话设置，会话不存在时创建会话，会话已经存在时只更新fields中的字段
+func (*conversationRepo) SaveSetting(userId int64, receiverType int32, receiverId int64, setting *Setting, fields []string) error {
+	updates := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !SettingFields[field] {
+			return gerrors.ErrBadRequest
+		}
+		updates = append(updates, field+" = values("+field+")")
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	err := db.DB.Exec("insert into conversation (user_id,receiver_type,receiver_id,is_top,is_mute,extra,ttl) values (?,?,?,?,?,?,?) "+
+		"on duplicate key update "+strings.Join(updates, ","),
+		userId, receiverType, receiverId, setting.IsTop, setting.IsMute, setting.Extra, setting.Ttl).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package conversation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestConversationRepo_UpdateLastMessage(t *testing.T) {
+	fmt.Println(ConversationRepo.UpdateLastMessage(1, 1, 2, 10, 100, time.Now(), 1, 10))
+}
+
+func TestConversationRepo_UpdateLastMessages(t *testing.T) {
+	fmt.Println(ConversationRepo.UpdateLastMessages([]LastMessage{
+		{UserId: 1, ReceiverType: 2, ReceiverId: 1, Seq: 11, MessageId: 101, SendTime: time.Now(), IsUnread: true},
+		{UserId: 2, ReceiverType: 2, ReceiverId: 1, Seq: 20, MessageId: 101, SendTime: time.Now(), IsUnread: true, IsMentioned: true},
+	}))
+}
+
+func TestConversationRepo_ClearMention(t *testing.T) {
+	fmt.Println(ConversationRepo.ClearMention(1, 1, 2, 10))
+}
+
+func TestConversationRepo_List(t *testing.T) {
+	conversations, hasMore, err := ConversationRepo.List(1, nil, 20)
+	fmt.Printf("%+v\n %+v\n %+v\n", conversations, hasMore, err)
+}
+
+func TestConversationRepo_SaveSetting(t *testing.T) {
+	fmt.Println(ConversationRepo.SaveSetting(1, 1, 2, &Setting{IsTop: true}, []string{SettingIsTop}))
+}
@@ -0,0 +1,88 @@
+package conversation
+
+import (
+	"context"
+	"gim/internal/logic/proxy"
+	"gim/pkg/pb"
+	"time"
+)
+
+type conversationService struct{}
+
+var ConversationService = new(conversationService)
+
+// UpdateLastMessage 用户收到一条消息后，更新对应会话的最后一条消息，isUnread表示这条消息是否计入未读
+func (*conversationService) UpdateLastMessage(ctx context.Context, userId int64, receiverType int32, receiverId int64,
+	seq, messageId int64, sendTime time.Time, isUnread, isMentioned bool) error {
+	var unreadIncr int64
+	if isUnread {
+		unreadIncr = 1
+	}
+	var mentionSeq int64
+	if isMentioned {
+		mentionSeq = seq
+	}
+	return ConversationRepo.UpdateLastMessage(userId, receiverType, receiverId, seq, messageId, sendTime, unreadIncr, mentionSeq)
+}
+
+// UpdateLastMessages 多个用户收到消息后，用一条语句批量更新对应会话的最后一条消息
+func (*conversationService) UpdateLastMessages(ctx context.Context, messages []LastMessage) error {
+	return ConversationRepo.UpdateLastMessages(messages)
+}
+
+// GetTtl 获取用户在会话中发送消息的默认阅后即焚时长，单位秒
+func (*conversationService) GetTtl(ctx context.Context, userId int64, receiverType int32, receiverId int64) (int32, error) {
+	conversation, err := ConversationRepo.Get(userId, receiverType, receiverId)
+	if err != nil {
+		return 0, err
+	}
+	if conversation == nil {
+		return 0, nil
+	}
+	return conversation.Ttl, nil
+}
+
+// List 分页获取用户的会话列表，cursor为上一页返回的分页位置
+func (*conversationService) List(ctx context.Context, userId int64, cursor string, limit int64) ([]Conversation, bool, error) {
+	listCursor, err := ParseCursor(cursor)
+	if err != nil {
+		return nil, false, err
+	}
+	if limit <= 0 || limit > ConversationLimit {
+		limit = ConversationLimit
+	}
+	return ConversationRepo.List(userId, listCursor, limit)
+}
+
+// Set 设置会话，只更新请求中指定的字段，没有指定时更新所有字段，并将更新后的设置同步给用户的所有设备
+func (*conversationService) Set(ctx context.Context, userId int64, req *pb.SetConversationReq) error {
+	fields := req.UpdateFields
+	if len(fields) == 0 {
+		fields = []string{SettingIsTop, SettingIsMute, SettingExtra, SettingTtl}
+	}
+	err := ConversationRepo.SaveSetting(userId, int32(req.ReceiverType), req.ReceiverId, &Setting{
+		IsTop:  req.IsTop,
+		IsMute: req.IsMute,
+		Extra:  req.Extra,
+		Ttl:    req.Ttl,
+	}, fields)
+	if err != nil {
+		return err
+	}
+
+	conversation, err := ConversationRepo.Get(userId, int32(req.ReceiverType), req.ReceiverId)
+	if err != nil {
+		return err
+	}
+	if conversation == nil {
+		return nil
+	}
+	return proxy.MessageProxy.PushToUser(ctx, userId, pb.PushCode_PC_UPDATE_CONVERSATION, &pb.UpdateConversationPush{
+		ReceiverType: req.ReceiverType,
+		ReceiverId:   req.ReceiverId,
+		IsTop:        conversation.IsTop,
+		IsMute:       conversation.IsMute,
+		Extra:        conversation.Extra,
+		Ttl:          conversation.Ttl,
+	}, true)
+}
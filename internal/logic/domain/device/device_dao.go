@@ -47,6 +47,16 @@ func (*deviceDao) ListOnlineByUserId(userId int64) ([]Device, error) {
 	return devices, nil
 }
 
+// ListOnlineByUserIds 批量查询用户的在线设备
+func (*deviceDao) ListOnlineByUserIds(userIds []int64) ([]Device, error) {
+	var devices []Device
+	err := db.DB.Find(&devices, "user_id in (?) and status = ?", userIds, DeviceOnLine).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return devices, nil
+}
+
 // ListOnlineByConnAddr 查询用户所有的在线设备
 func (*deviceDao) ListOnlineByConnAddr(connAddr string) ([]Device, error) {
 	var devices []Device
@@ -36,3 +36,9 @@ func TestDeviceDao_ListOnlineByUserId(t *testing.T) {
 	fmt.Println(err)
 	fmt.Printf("%+v \n", devices)
 }
+
+func TestDeviceDao_ListOnlineByUserIds(t *testing.T) {
+	devices, err := DeviceDao.ListOnlineByUserIds([]int64{1, 2})
+	fmt.Println(err)
+	fmt.Printf("%+v \n", devices)
+}
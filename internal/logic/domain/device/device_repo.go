@@ -53,6 +53,49 @@ func (*deviceRepo) ListOnlineByUserId(userId int64) ([]Device, error) {
 	return devices, nil
 }
 
+// ListOnlineByUserIds 批量获取用户的所有在线设备，先查缓存，缓存中没有的用户再批量查询DB
+func (*deviceRepo) ListOnlineByUserIds(userIds []int64) (map[int64][]Device, error) {
+	if len(userIds) == 0 {
+		return map[int64][]Device{}, nil
+	}
+	result, err := UserDeviceCache.MGet(userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	missUserIds := make([]int64, 0, len(userIds))
+	for _, userId := range userIds {
+		if _, ok := result[userId]; !ok {
+			missUserIds = append(missUserIds, userId)
+		}
+	}
+	if len(missUserIds) == 0 {
+		return result, nil
+	}
+
+	devices, err := DeviceDao.ListOnlineByUserIds(missUserIds)
+	if err != nil {
+		return nil, err
+	}
+	missDevices := make(map[int64][]Device, len(missUserIds))
+	for _, userId := range missUserIds {
+		// 没有在线设备的用户缓存空列表，避免每次都查询DB
+		missDevices[userId] = []Device{}
+	}
+	for i := range devices {
+		missDevices[devices[i].UserId] = append(missDevices[devices[i].UserId], devices[i])
+	}
+
+	err = UserDeviceCache.MSet(missDevices)
+	if err != nil {
+		return nil, err
+	}
+	for userId, userDevices := range missDevices {
+		result[userId] = userDevices
+	}
+	return result, nil
+}
+
 // ListOnlineByConnAddr 查询用户所有的在线设备
 func (*deviceRepo) ListOnlineByConnAddr(connAddr string) ([]Device, error) {
 	return DeviceDao.ListOnlineByConnAddr(connAddr)
@@ -74,20 +74,40 @@ func (*deviceService) ListOnlineByUserId(ctx context.Context, userId int64) ([]*
 	return pbDevices, nil
 }
 
-// ServerStop connect服务停止，需要将连接在当前connect上的设备标记为下线
-func (*deviceService) ServerStop(ctx context.Context, connAddr string) error {
+// ListOnlineByUserIds 批量获取用户的所有在线设备
+func (*deviceService) ListOnlineByUserIds(ctx context.Context, userIds []int64) (map[int64][]*pb.Device, error) {
+	userDevices, err := DeviceRepo.ListOnlineByUserIds(userIds)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64][]*pb.Device, len(userDevices))
+	for userId, devices := range userDevices {
+		pbDevices := make([]*pb.Device, len(devices))
+		for i := range devices {
+			pbDevices[i] = devices[i].ToProto()
+		}
+		result[userId] = pbDevices
+	}
+	return result, nil
+}
+
+// ServerStop connect服务停止，需要将连接在当前connect上的设备标记为下线，返回成功标记为下线的设备
+func (*deviceService) ServerStop(ctx context.Context, connAddr string) ([]Device, error) {
 	devices, err := DeviceRepo.ListOnlineByConnAddr(connAddr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	offline := make([]Device, 0, len(devices))
 	for i := range devices {
 		// 因为是异步修改设备转台，要避免设备重连，导致状态不一致
 		err = DeviceRepo.UpdateStatusOffline(devices[i])
 		if err != nil {
 			logger.Logger.Error("DeviceRepo.Save error", zap.Any("device", devices[i]), zap.Error(err))
+		} else {
+			offline = append(offline, devices[i])
 		}
 		time.Sleep(2 * time.Millisecond)
 	}
-	return nil
+	return offline, nil
 }
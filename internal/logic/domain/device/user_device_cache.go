@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/go-redis/redis"
+	jsoniter "github.com/json-iterator/go"
 )
 
 const (
@@ -38,6 +39,52 @@ func (c *userDeviceCache) Set(userId int64, devices []Device) error {
 	return gerrors.WrapError(err)
 }
 
+// MGet 批量获取用户的所有在线设备，没有缓存的用户不在返回结果中
+func (c *userDeviceCache) MGet(userIds []int64) (map[int64][]Device, error) {
+	keys := make([]string, len(userIds))
+	for i := range userIds {
+		keys[i] = UserDeviceKey + strconv.FormatInt(userIds[i], 10)
+	}
+	values, err := db.RedisCli.MGet(keys...).Result()
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+
+	result := make(map[int64][]Device, len(userIds))
+	for i := range values {
+		value, ok := values[i].(string)
+		if !ok {
+			continue
+		}
+		var devices []Device
+		err = jsoniter.Unmarshal([]byte(value), &devices)
+		if err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		// 缓存的空列表也是有效的缓存
+		if devices == nil {
+			continue
+		}
+		result[userIds[i]] = devices
+	}
+	return result, nil
+}
+
+// MSet 批量将用户的所有在线设备存入缓存
+func (c *userDeviceCache) MSet(userDevices map[int64][]Device) error {
+	pipeline := db.RedisCli.Pipeline()
+	defer pipeline.Close()
+	for userId, devices := range userDevices {
+		bytes, err := jsoniter.Marshal(devices)
+		if err != nil {
+			return gerrors.WrapError(err)
+		}
+		pipeline.Set(UserDeviceKey+strconv.FormatInt(userId, 10), bytes, UserDeviceExpire)
+	}
+	_, err := pipeline.Exec()
+	return gerrors.WrapError(err)
+}
+
 // Del 删除用户的在线设备列表
 func (c *userDeviceCache) Del(userId int64) error {
 	key := UserDeviceKey + strconv.FormatInt(userId, 10)
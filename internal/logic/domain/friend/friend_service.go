@@ -102,22 +102,22 @@ func (*friendService) AddFriend(ctx context.Context, userId, friendId int64, rem
 	return nil
 }
 
-// AgreeAddFriend 同意添加好友
-func (*friendService) AgreeAddFriend(ctx context.Context, userId, friendId int64, remarks string) error {
+// AgreeAddFriend 同意添加好友，返回是否新建立了好友关系，已经是好友时返回false
+func (*friendService) AgreeAddFriend(ctx context.Context, userId, friendId int64, remarks string) (bool, error) {
 	friend, err := FriendRepo.Get(friendId, userId)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if friend == nil {
-		return gerrors.ErrBadRequest
+		return false, gerrors.ErrBadRequest
 	}
 	if friend.Status == FriendStatusAgree {
-		return nil
+		return false, nil
 	}
 	friend.Status = FriendStatusAgree
 	err = FriendRepo.Save(friend)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	now := time.Now()
@@ -130,12 +130,12 @@ func (*friendService) AgreeAddFriend(ctx context.Context, userId, friendId int64
 		UpdateTime: now,
 	})
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	resp, err := rpc.BusinessIntClient.GetUser(ctx, &pb.GetUserReq{UserId: userId})
 	if err != nil {
-		return err
+		return true, err
 	}
 
 	// 将同意添加好友的信息发送给friendId的用户
@@ -145,21 +145,21 @@ func (*friendService) AgreeAddFriend(ctx context.Context, userId, friendId int64
 		AvatarUrl: resp.User.AvatarUrl,
 	}, true)
 	if err != nil {
-		return err
+		return true, err
 	}
-	return nil
+	return true, nil
 }
 
 // SendToFriend 消息发送至好友
-func (*friendService) SendToFriend(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
+func (*friendService) SendToFriend(ctx context.Context, messageId int64, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
 	// 发给发送者，因为发送消息后，也要在界面上显示自己发的内容。
-	seq, err := proxy.MessageProxy.SendToUser(ctx, sender, sender.SenderId, req)
+	seq, err := proxy.MessageProxy.SendToUser(ctx, messageId, sender, sender.SenderId, req)
 	if err != nil {
 		return 0, err
 	}
 
 	// 发给接收者
-	_, err = proxy.MessageProxy.SendToUser(ctx, sender, req.ReceiverId, req)
+	_, err = proxy.MessageProxy.SendToUser(ctx, messageId, sender, req.ReceiverId, req)
 	if err != nil {
 		return 0, err
 	}
@@ -126,19 +126,23 @@ func (g *Group) PushUpdate(ctx context.Context, userId int64) error {
 	return nil
 }
 
-// SendMessage 消息发送至群组，遍历成员，向每个成员发送消息(写扩散)
-func (g *Group) SendMessage(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
+// SendMessage 消息发送至群组，遍历成员，向每个成员发送消息(写扩散)，所有成员的消息副本共用messageId
+func (g *Group) SendMessage(ctx context.Context, messageId int64, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
 	if sender.SenderType == pb.SenderType_ST_USER && !g.IsMember(sender.SenderId) {
 		logger.Sugar.Error(ctx, sender.SenderId, req.ReceiverId, "不在群组内")
 		return 0, gerrors.ErrNotInGroup
 	}
 
+	err := g.CheckMentions(ctx, sender, req)
+	if err != nil {
+		return 0, err
+	}
+
 	// 如果发送者是用户，将消息发送给发送者,获取用户seq
 	var userSeq int64
-	var err error
 	// 用户自己也需要收到信息，自己的操作要在自己界面中显示
 	if sender.SenderType == pb.SenderType_ST_USER {
-		userSeq, err = proxy.MessageProxy.SendToUser(ctx, sender, sender.SenderId, req)
+		userSeq, err = proxy.MessageProxy.SendToUser(ctx, messageId, sender, sender.SenderId, req)
 		if err != nil {
 			return 0, err
 		}
@@ -147,15 +151,23 @@ func (g *Group) SendMessage(ctx context.Context, sender *pb.Sender, req *pb.Send
 	go func() {
 		defer util.RecoverPanic()
 		// 将消息发送给群组用户，使用写扩散
+		userIds := make([]int64, 0, len(g.Members))
 		for _, user := range g.Members {
 			// 前面已经发送过，这里不需要再发送
 			if sender.SenderType == pb.SenderType_ST_USER && user.UserId == sender.SenderId {
 				continue
 			}
-			// 向群组其他成员发送信息，新建ctx的意义？
-			_, err := proxy.MessageProxy.SendToUser(grpclib.NewAndCopyRequestId(ctx), sender, user.UserId, req)
+			userIds = append(userIds, user.UserId)
+		}
+
+		// 批量发送，发送失败的成员再逐个重试，一个成员失败不影响其他成员
+		sendCtx := grpclib.NewAndCopyRequestId(ctx)
+		failed := proxy.MessageProxy.SendToUsers(sendCtx, messageId, sender, userIds, req)
+		for _, userId := range failed {
+			_, err := proxy.MessageProxy.SendToUser(sendCtx, messageId, sender, userId, req)
 			if err != nil {
-				return
+				logger.Logger.Error("send group message error", zap.Int64("group_id", g.Id), zap.Int64("user_id", userId),
+					zap.Int64("message_id", messageId), zap.Error(err))
 			}
 		}
 	}()
@@ -172,6 +184,31 @@ func (g *Group) IsMember(userId int64) bool {
 	return false
 }
 
+// CheckMentions 校验消息中@的用户，被@的用户必须是群组成员，用户发送@所有人时需要是群组管理员
+func (g *Group) CheckMentions(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) error {
+	if req.IsMentionAll && sender.SenderType == pb.SenderType_ST_USER {
+		member := g.GetMember(ctx, sender.SenderId)
+		if member == nil || member.MemberType != int(pb.MemberType_GMT_ADMIN) {
+			return gerrors.ErrNotGroupAdmin
+		}
+	}
+
+	toUserIds := make([]int64, 0, len(req.ToUserIds))
+	exists := make(map[int64]bool, len(req.ToUserIds))
+	for _, userId := range req.ToUserIds {
+		if exists[userId] {
+			continue
+		}
+		if !g.IsMember(userId) {
+			return gerrors.ErrMentionNotInGroup
+		}
+		exists[userId] = true
+		toUserIds = append(toUserIds, userId)
+	}
+	req.ToUserIds = toUserIds
+	return nil
+}
+
 // PushMessage 向群组推送消息，code为指令码，message是消息具体内容，isPersist是否持久化到DB
 func (g *Group) PushMessage(ctx context.Context, code pb.PushCode, message proto.Message, isPersist bool) error {
 	logger.Logger.Debug("push_to_group",
@@ -191,8 +228,13 @@ func (g *Group) PushMessage(ctx context.Context, code pb.PushCode, message proto
 		return gerrors.WrapError(err)
 	}
 
+	messageId, err := proxy.MessageProxy.NewMessageId()
+	if err != nil {
+		return err
+	}
+
 	// PushMessage推送的都是添加用户、删除用户等系统信息SenderType_ST_SYSTEM，也是指令信息MessageType_MT_COMMAND
-	_, err = g.SendMessage(ctx,
+	_, err = g.SendMessage(ctx, messageId,
 		&pb.Sender{
 			SenderType: pb.SenderType_ST_SYSTEM,
 			SenderId:   0,
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"context"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+	"testing"
+)
+
+func TestGroup_CheckMentions(t *testing.T) {
+	group := &Group{Members: []GroupUser{
+		{UserId: 1, MemberType: int(pb.MemberType_GMT_ADMIN)},
+		{UserId: 2, MemberType: int(pb.MemberType_GMT_MEMBER)},
+		{UserId: 3, MemberType: int(pb.MemberType_GMT_MEMBER)},
+	}}
+	admin := &pb.Sender{SenderType: pb.SenderType_ST_USER, SenderId: 1}
+	member := &pb.Sender{SenderType: pb.SenderType_ST_USER, SenderId: 2}
+	business := &pb.Sender{SenderType: pb.SenderType_ST_BUSINESS, SenderId: 100}
+
+	req := &pb.SendMessageReq{ToUserIds: []int64{2, 3, 2}}
+	if err := group.CheckMentions(context.TODO(), member, req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.ToUserIds) != 2 || req.ToUserIds[0] != 2 || req.ToUserIds[1] != 3 {
+		t.Fatalf("to user ids = %v", req.ToUserIds)
+	}
+
+	req = &pb.SendMessageReq{ToUserIds: []int64{2, 4}}
+	if err := group.CheckMentions(context.TODO(), admin, req); err != gerrors.ErrMentionNotInGroup {
+		t.Fatalf("err = %v", err)
+	}
+
+	req = &pb.SendMessageReq{IsMentionAll: true}
+	if err := group.CheckMentions(context.TODO(), member, req); err != gerrors.ErrNotGroupAdmin {
+		t.Fatalf("err = %v", err)
+	}
+	if err := group.CheckMentions(context.TODO(), admin, req); err != nil {
+		t.Fatal(err)
+	}
+	if err := group.CheckMentions(context.TODO(), business, req); err != nil {
+		t.Fatal(err)
+	}
+}
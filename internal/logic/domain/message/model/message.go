@@ -1,32 +1,65 @@
 package model
 
 import (
+	"gim/pkg/gerrors"
 	"gim/pkg/logger"
 	"gim/pkg/pb"
 	"gim/pkg/util"
 	"strconv"
 	"strings"
 	"time"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // Message 消息
 type Message struct {
-	Id           int64     // 自增主键
-	UserId       int64     // 所属类型id
-	RequestId    int64     // 请求id
-	SenderType   int32     // 发送者类型
-	SenderId     int64     // 发送者账户id
-	ReceiverType int32     // 接收者账户id
-	ReceiverId   int64     // 接收者id,如果是单聊信息，则为user_id，如果是群组消息，则为group_id
-	ToUserIds    string    // 需要@的用户id列表，多个用户用，隔开
-	Type         int       // 消息类型
-	Content      []byte    // 消息内容
-	Seq          int64     // 消息同步序列
-	SendTime     time.Time // 消息发送时间
-	Status       int32     // 创建时间
+	Id            int64      // 自增主键
+	UserId        int64      // 所属类型id
+	RequestId     int64      // 请求id
+	SenderType    int32      // 发送者类型
+	SenderId      int64      // 发送者账户id
+	ReceiverType  int32      // 接收者账户id
+	ReceiverId    int64      // 接收者id,如果是单聊信息，则为user_id，如果是群组消息，则为group_id
+	ToUserIds     string     // 需要@的用户id列表，多个用户用，隔开
+	Type          int        // 消息类型
+	Content       []byte     // 消息内容
+	Seq           int64      // 消息同步序列
+	MessageId     int64      // 消息id，同一条消息的所有副本共用
+	SendTime      time.Time  // 消息发送时间
+	Status        int32      // 消息状态
+	ReplyTo       []byte     // 引用回复的消息快照，pb.ReplyTo序列化后的字节
+	ThreadRootId  int64      // 所属话题根消息的id，0表示不在话题中
+	ReplyCount    int32      // 话题回复数
+	LastReplyTime *time.Time // 话题最后回复时间
+	EditTime      *time.Time // 最后编辑时间，为nil表示没有编辑过
+	IsDeleted     bool       // 是否被用户从自己的消息列表中删除
+	Ttl           int32      // 阅后即焚时长，单位秒，0表示不是阅后即焚消息
+	ExpireTime    *time.Time // 阅后即焚消息的销毁时间，销毁后为nil
+	Extra         string     // 附加字段
+	IsMentionAll  bool       // 是否@所有人
+	IsMentioned   bool       // 消息所属用户是否被@
+	CreateTime    time.Time  // 创建时间
 }
 
 func (m *Message) MessageToPB() *pb.Message {
+	content := m.Content
+	// 已撤回和已销毁的消息不再下发消息内容
+	if !m.HasContent() {
+		content = nil
+	}
+	var lastReplyTime int64
+	if m.LastReplyTime != nil {
+		lastReplyTime = util.UnixMilliTime(*m.LastReplyTime)
+	}
+	var editTime int64
+	if m.EditTime != nil {
+		editTime = util.UnixMilliTime(*m.EditTime)
+	}
+	var expireTime int64
+	if m.ExpireTime != nil {
+		expireTime = util.UnixMilliTime(*m.ExpireTime)
+	}
 	return &pb.Message{
 		Sender: &pb.Sender{
 			SenderType: pb.SenderType(m.SenderType),
@@ -36,11 +69,111 @@ func (m *Message) MessageToPB() *pb.Message {
 		ReceiverId:     m.ReceiverId,
 		ToUserIds:      UnformatUserIds(m.ToUserIds),
 		MessageType:    pb.MessageType(m.Type),
-		MessageContent: m.Content,
+		MessageContent: content,
 		Seq:            m.Seq,
 		SendTime:       util.UnixMilliTime(m.SendTime),
 		Status:         pb.MessageStatus(m.Status),
+		MessageId:      m.MessageId,
+		ReplyTo:        m.GetReplyTo(),
+		ReplyCount:     m.ReplyCount,
+		LastReplyTime:  lastReplyTime,
+		IsEdited:       m.EditTime != nil,
+		EditTime:       editTime,
+		Ttl:            m.Ttl,
+		ExpireTime:     expireTime,
+		Extra:          m.Extra,
+		IsMentionAll:   m.IsMentionAll,
+		IsMentioned:    m.IsMentioned,
+	}
+}
+
+// GetReplyTo 获取引用回复的消息快照，没有引用返回nil
+func (m *Message) GetReplyTo() *pb.ReplyTo {
+	if len(m.ReplyTo) == 0 {
+		return nil
+	}
+	var replyTo pb.ReplyTo
+	err := proto.Unmarshal(m.ReplyTo, &replyTo)
+	if err != nil {
+		logger.Sugar.Error(err)
+		return nil
+	}
+	return &replyTo
+}
+
+// ReplyToPB 生成引用这条消息时的快照，isThread为true时回复归入这条消息所在的话题，不在话题中时以这条消息作为话题根消息
+func (m *Message) ReplyToPB(isThread bool) *pb.ReplyTo {
+	replyTo := &pb.ReplyTo{
+		MessageId: m.MessageId,
+		IsThread:  isThread,
+		Sender: &pb.Sender{
+			SenderType: pb.SenderType(m.SenderType),
+			SenderId:   m.SenderId,
+		},
+		MessageType:    pb.MessageType(m.Type),
+		MessageContent: m.Content,
+		SendTime:       util.UnixMilliTime(m.SendTime),
+	}
+	// 阅后即焚消息的内容不能保存在快照中
+	if !m.HasContent() || m.Ttl > 0 {
+		replyTo.MessageContent = nil
+	}
+	if isThread {
+		replyTo.ThreadRootId = m.ThreadRootId
+		if replyTo.ThreadRootId == 0 {
+			replyTo.ThreadRootId = m.MessageId
+		}
+	}
+	return replyTo
+}
+
+// HasContent 消息内容是否可以下发，已撤回和已销毁的消息没有内容
+func (m *Message) HasContent() bool {
+	return m.Status != int32(pb.MessageStatus_MS_RECALL) && m.Status != int32(pb.MessageStatus_MS_DESTROYED)
+}
+
+// ConversationOf 获取消息在用户userId的消息列表中所属的会话，单聊为对方用户id，群聊为群组id
+func (m *Message) ConversationOf(userId int64) (int32, int64) {
+	if m.ReceiverType == int32(pb.ReceiverType_RT_USER) && m.ReceiverId == userId {
+		return m.ReceiverType, m.SenderId
+	}
+	return m.ReceiverType, m.ReceiverId
+}
+
+// IsSentBy 消息是否是用户userId发送的
+func (m *Message) IsSentBy(userId int64) bool {
+	return m.SenderType == int32(pb.SenderType_ST_USER) && m.SenderId == userId
+}
+
+// CheckRecall 检查用户是否可以撤回这条消息，只有发送者本人在撤回时限内可以撤回
+func (m *Message) CheckRecall(userId int64, duration time.Duration) error {
+	if !m.IsSentBy(userId) {
+		return gerrors.ErrNotMessageOwner
+	}
+	if time.Since(m.CreateTime) > duration {
+		return gerrors.ErrRecallTimeout
+	}
+	return nil
+}
+
+// CheckEdit 检查用户是否可以编辑这条消息，只有发送者本人在编辑时限内可以编辑文本消息
+func (m *Message) CheckEdit(userId int64, duration time.Duration) error {
+	if !m.IsSentBy(userId) {
+		return gerrors.ErrNotMessageOwner
+	}
+	if m.Type != int(pb.MessageType_MT_TEXT) {
+		return gerrors.ErrBadRequest
+	}
+	if m.Status == int32(pb.MessageStatus_MS_RECALL) {
+		return gerrors.ErrMessageRecalled
+	}
+	if m.Status == int32(pb.MessageStatus_MS_DESTROYED) {
+		return gerrors.ErrMessageNotFound
+	}
+	if time.Since(m.CreateTime) > duration {
+		return gerrors.ErrEditTimeout
 	}
+	return nil
 }
 
 func FormatUserIds(userId []int64) string {
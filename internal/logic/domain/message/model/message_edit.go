@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// MessageEdit 消息编辑历史，保存每次编辑前的消息内容
+type MessageEdit struct {
+	Id         int64     // 自增主键
+	MessageId  int64     // 消息id
+	Content    []byte    // 编辑前的消息内容
+	SendTime   time.Time // 这个版本的发送或者编辑时间
+	CreateTime time.Time // 创建时间
+}
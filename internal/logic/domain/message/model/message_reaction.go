@@ -0,0 +1,36 @@
+package model
+
+import (
+	"gim/pkg/pb"
+	"time"
+)
+
+// MessageReaction 用户对消息的表情回应，同一条消息的所有副本共用
+type MessageReaction struct {
+	Id         int64     // 自增主键
+	MessageId  int64     // 消息id
+	UserId     int64     // 回应的用户id
+	Emoji      string    // 表情
+	CreateTime time.Time // 创建时间
+}
+
+// ReactionsToPB 按消息id和表情汇总表情回应，reactions需要按回应时间排序
+func ReactionsToPB(reactions []MessageReaction) map[int64][]*pb.Reaction {
+	result := make(map[int64][]*pb.Reaction)
+	for _, reaction := range reactions {
+		var summary *pb.Reaction
+		for _, r := range result[reaction.MessageId] {
+			if r.Emoji == reaction.Emoji {
+				summary = r
+				break
+			}
+		}
+		if summary == nil {
+			summary = &pb.Reaction{Emoji: reaction.Emoji}
+			result[reaction.MessageId] = append(result[reaction.MessageId], summary)
+		}
+		summary.Count++
+		summary.UserIds = append(summary.UserIds, reaction.UserId)
+	}
+	return result
+}
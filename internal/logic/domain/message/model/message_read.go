@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// MessageRead 用户在会话中的已读位置
+type MessageRead struct {
+	Id           int64     // 自增主键
+	UserId       int64     // 用户id
+	ReceiverType int32     // 会话类型
+	ReceiverId   int64     // 单聊为对方用户id，群聊为群组id
+	ReadSeq      int64     // 已读到的消息序列号
+	CreateTime   time.Time // 创建时间
+	UpdateTime   time.Time // 更新时间
+}
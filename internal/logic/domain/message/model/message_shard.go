@@ -0,0 +1,132 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 分表迁移状态
+const (
+	ShardStatusNormal    = 0 // 正常，只读写当前分表
+	ShardStatusDualWrite = 1 // 迁移中，同时写入当前分表和新分表，从当前分表读取
+	ShardStatusSwitched  = 2 // 已切换，同时写入当前分表和新分表，从新分表读取
+	ShardStatusCopied    = 3 // 存量消息已复制，同时写入当前分表和新分表，从当前分表读取
+)
+
+// MessageLayout 消息表的一种分表方式，按照用户id取模分表
+type MessageLayout struct {
+	Version  int // 分表版本，每次重新分表加一
+	TableNum int // 分表数量
+}
+
+// TableName 第index张消息表的表名，版本0沿用最初的表名message_000
+func (l MessageLayout) TableName(index int) string {
+	if l.Version == 0 {
+		return fmt.Sprintf("message_%03d", index)
+	}
+	return fmt.Sprintf("message_v%d_%03d", l.Version, index)
+}
+
+// UserTableName 用户消息所在的消息表
+func (l MessageLayout) UserTableName(userId int64) string {
+	return l.TableName(int(userId % int64(l.TableNum)))
+}
+
+// TableNames 所有消息表的表名
+func (l MessageLayout) TableNames() []string {
+	names := make([]string, 0, l.TableNum)
+	for i := 0; i < l.TableNum; i++ {
+		names = append(names, l.TableName(i))
+	}
+	return names
+}
+
+// MessageShard 消息表的分表配置，重新分表时记录迁移状态
+type MessageShard struct {
+	Id           int64     // 自增主键
+	Version      int       // 当前分表版本
+	TableNum     int       // 当前分表数量
+	NextVersion  int       // 迁移目标的分表版本
+	NextTableNum int       // 迁移目标的分表数量
+	Status       int       // 迁移状态
+	CopiedMaxIds string    // 存量消息复制完成时当前分表每张表的最大主键，按照表的顺序用逗号分隔
+	CreateTime   time.Time // 创建时间
+	UpdateTime   time.Time // 更新时间
+}
+
+// Current 当前分表
+func (s *MessageShard) Current() MessageLayout {
+	return MessageLayout{Version: s.Version, TableNum: s.TableNum}
+}
+
+// Next 迁移目标的分表
+func (s *MessageShard) Next() MessageLayout {
+	return MessageLayout{Version: s.NextVersion, TableNum: s.NextTableNum}
+}
+
+// GetCopiedMaxIds 存量消息复制完成时当前分表每张表的最大主键，没有记录的表为0
+func (s *MessageShard) GetCopiedMaxIds() []int64 {
+	maxIds := make([]int64, s.TableNum)
+	if s.CopiedMaxIds == "" {
+		return maxIds
+	}
+	for i, str := range strings.Split(s.CopiedMaxIds, ",") {
+		if i >= len(maxIds) {
+			break
+		}
+		maxIds[i], _ = strconv.ParseInt(str, 10, 64)
+	}
+	return maxIds
+}
+
+// SetCopiedMaxIds 记录存量消息复制完成时当前分表每张表的最大主键
+func (s *MessageShard) SetCopiedMaxIds(maxIds []int64) {
+	strs := make([]string, len(maxIds))
+	for i := range maxIds {
+		strs[i] = strconv.FormatInt(maxIds[i], 10)
+	}
+	s.CopiedMaxIds = strings.Join(strs, ",")
+}
+
+// ReadLayout 读取消息使用的分表
+func (s *MessageShard) ReadLayout() MessageLayout {
+	if s.Status == ShardStatusSwitched {
+		return s.Next()
+	}
+	return s.Current()
+}
+
+// WriteLayouts 写入消息需要写的分表，迁移过程中先写当前分表，再写新分表
+func (s *MessageShard) WriteLayouts() []MessageLayout {
+	if s.Status == ShardStatusNormal {
+		return []MessageLayout{s.Current()}
+	}
+	return []MessageLayout{s.Current(), s.Next()}
+}
+
+// State 分表配置的状态，实例上报自己加载的状态，用于确认所有实例都已经切换
+func (s *MessageShard) State() string {
+	return s.StateOf(s.Status)
+}
+
+// StateOf 分表配置处于status时的状态
+func (s *MessageShard) StateOf(status int) string {
+	return fmt.Sprintf("%d:%d:%d", s.Version, s.NextVersion, status)
+}
+
+var autoIncrementRegexp = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
+
+// BuildDDL 根据模板表的建表语句，生成分表的建表语句，去掉模板表的自增起始值
+func BuildDDL(template, templateName string, layout MessageLayout) string {
+	template = autoIncrementRegexp.ReplaceAllString(template, "")
+	var builder strings.Builder
+	for _, name := range layout.TableNames() {
+		ddl := strings.Replace(template, "CREATE TABLE `"+templateName+"`", "CREATE TABLE IF NOT EXISTS `"+name+"`", 1)
+		builder.WriteString(ddl)
+		builder.WriteString(";\n\n")
+	}
+	return builder.String()
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMessageLayout_TableName(t *testing.T) {
+	layout := MessageLayout{Version: 0, TableNum: 1}
+	if name := layout.UserTableName(1001); name != "message_000" {
+		t.Fatalf("name = %s", name)
+	}
+
+	layout = MessageLayout{Version: 2, TableNum: 4}
+	if name := layout.UserTableName(1001); name != "message_v2_001" {
+		t.Fatalf("name = %s", name)
+	}
+	names := layout.TableNames()
+	if len(names) != 4 || names[0] != "message_v2_000" || names[3] != "message_v2_003" {
+		t.Fatalf("names = %v", names)
+	}
+}
+
+func TestMessageShard_Layouts(t *testing.T) {
+	shard := &MessageShard{Version: 0, TableNum: 1, NextVersion: 1, NextTableNum: 4, Status: ShardStatusNormal}
+	if len(shard.WriteLayouts()) != 1 || shard.ReadLayout() != shard.Current() {
+		t.Fatal("normal shard should only use current layout")
+	}
+
+	shard.Status = ShardStatusDualWrite
+	layouts := shard.WriteLayouts()
+	if len(layouts) != 2 || layouts[0] != shard.Current() || layouts[1] != shard.Next() || shard.ReadLayout() != shard.Current() {
+		t.Fatal("dual write shard should write both layouts and read current layout")
+	}
+
+	shard.Status = ShardStatusCopied
+	if len(shard.WriteLayouts()) != 2 || shard.ReadLayout() != shard.Current() {
+		t.Fatal("copied shard should write both layouts and read current layout")
+	}
+
+	shard.Status = ShardStatusSwitched
+	if len(shard.WriteLayouts()) != 2 || shard.ReadLayout() != shard.Next() {
+		t.Fatal("switched shard should write both layouts and read next layout")
+	}
+	if shard.State() != "0:1:2" || shard.StateOf(ShardStatusCopied) != "0:1:3" {
+		t.Fatalf("state = %s", shard.State())
+	}
+}
+
+func TestMessageShard_CopiedMaxIds(t *testing.T) {
+	shard := &MessageShard{TableNum: 3}
+	if maxIds := shard.GetCopiedMaxIds(); len(maxIds) != 3 || maxIds[0] != 0 || maxIds[2] != 0 {
+		t.Fatalf("max ids = %v", maxIds)
+	}
+
+	shard.SetCopiedMaxIds([]int64{10, 0, 25})
+	if shard.CopiedMaxIds != "10,0,25" {
+		t.Fatalf("copied max ids = %s", shard.CopiedMaxIds)
+	}
+	if maxIds := shard.GetCopiedMaxIds(); len(maxIds) != 3 || maxIds[0] != 10 || maxIds[1] != 0 || maxIds[2] != 25 {
+		t.Fatalf("max ids = %v", maxIds)
+	}
+}
+
+func TestBuildDDL(t *testing.T) {
+	template := "CREATE TABLE `message_000` (\n  `id` bigint(20) NOT NULL AUTO_INCREMENT\n) ENGINE=InnoDB AUTO_INCREMENT=1024 DEFAULT CHARSET=utf8mb4"
+	ddl := BuildDDL(template, "message_000", MessageLayout{Version: 1, TableNum: 2})
+	if strings.Contains(ddl, "AUTO_INCREMENT=1024") || strings.Contains(ddl, "`message_000`") {
+		t.Fatalf("ddl = %s", ddl)
+	}
+	if !strings.Contains(ddl, "CREATE TABLE IF NOT EXISTS `message_v1_000`") || !strings.Contains(ddl, "CREATE TABLE IF NOT EXISTS `message_v1_001`") {
+		t.Fatalf("ddl = %s", ddl)
+	}
+	if !strings.Contains(ddl, "NOT NULL AUTO_INCREMENT") {
+		t.Fatalf("ddl = %s", ddl)
+	}
+}
@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"fmt"
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"time"
+
+	"github.com/go-redis/redis"
+	"google.golang.org/protobuf/proto"
+)
+
+const MessageDedupKey = "message_dedup:%d:%d:%s"
+
+type messageDedupRepo struct{}
+
+var MessageDedupRepo = new(messageDedupRepo)
+
+func (*messageDedupRepo) key(senderType pb.SenderType, senderId int64, clientKey string) string {
+	return fmt.Sprintf(MessageDedupKey, senderType, senderId, clientKey)
+}
+
+// Acquire 标记消息正在发送，如果消息已经被标记过，返回false
+func (r *messageDedupRepo) Acquire(senderType pb.SenderType, senderId int64, clientKey string, duration time.Duration) (bool, error) {
+	ok, err := db.RedisCli.SetNX(r.key(senderType, senderId, clientKey), "", duration).Result()
+	if err != nil {
+		return false, gerrors.WrapError(err)
+	}
+	return ok, nil
+}
+
+// Get 获取消息的发送结果，如果消息还在发送中，返回nil
+func (r *messageDedupRepo) Get(senderType pb.SenderType, senderId int64, clientKey string) (*pb.SendMessageResp, error) {
+	value, err := db.RedisCli.Get(r.key(senderType, senderId, clientKey)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, gerrors.WrapError(err)
+	}
+	if err == redis.Nil || value == "" {
+		return nil, nil
+	}
+
+	var resp pb.SendMessageResp
+	err = proto.Unmarshal(util.Str2bytes(value), &resp)
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return &resp, nil
+}
+
+// Set 保存消息的发送结果
+func (r *messageDedupRepo) Set(senderType pb.SenderType, senderId int64, clientKey string, resp *pb.SendMessageResp, duration time.Duration) error {
+	buf, err := proto.Marshal(resp)
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	err = db.RedisCli.Set(r.key(senderType, senderId, clientKey), buf, duration).Err()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// Del 删除消息的发送标记
+func (r *messageDedupRepo) Del(senderType pb.SenderType, senderId int64, clientKey string) error {
+	err := db.RedisCli.Del(r.key(senderType, senderId, clientKey)).Err()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"fmt"
+	"gim/pkg/pb"
+	"testing"
+	"time"
+)
+
+func TestMessageDedupRepo(t *testing.T) {
+	fmt.Println(MessageDedupRepo.Acquire(pb.SenderType_ST_USER, 1, "key", time.Minute))
+	fmt.Println(MessageDedupRepo.Set(pb.SenderType_ST_USER, 1, "key", &pb.SendMessageResp{Seq: 1, MessageId: 1}, time.Minute))
+	fmt.Println(MessageDedupRepo.Get(pb.SenderType_ST_USER, 1, "key"))
+}
@@ -0,0 +1,59 @@
+package repo
+
+import (
+	"gim/internal/logic/domain/message/model"
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+)
+
+type messageEditRepo struct{}
+
+var MessageEditRepo = new(messageEditRepo)
+
+// Save 保存一条编辑历史
+func (*messageEditRepo) Save(edit *model.MessageEdit) error {
+	err := db.DB.Create(edit).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// ListByMessageIds 批量获取消息的编辑历史，key为消息id，按编辑顺序排序
+func (*messageEditRepo) ListByMessageIds(messageIds []int64) (map[int64][]model.MessageEdit, error) {
+	if len(messageIds) == 0 {
+		return nil, nil
+	}
+	var edits []model.MessageEdit
+	err := db.DB.Where("message_id in (?)", messageIds).Order("id").Find(&edits).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	result := make(map[int64][]model.MessageEdit, len(edits))
+	for i := range edits {
+		result[edits[i].MessageId] = append(result[edits[i].MessageId], edits[i])
+	}
+	return result, nil
+}
+
+// Exists 消息是否有编辑历史
+func (*messageEditRepo) Exists(messageId int64) (bool, error) {
+	var count int64
+	err := db.DB.Model(&model.MessageEdit{}).Where("message_id = ?", messageId).Count(&count).Error
+	if err != nil {
+		return false, gerrors.WrapError(err)
+	}
+	return count > 0, nil
+}
+
+// DeleteByMessageIds 删除消息的编辑历史
+func (*messageEditRepo) DeleteByMessageIds(messageIds []int64) error {
+	if len(messageIds) == 0 {
+		return nil
+	}
+	err := db.DB.Where("message_id in (?)", messageIds).Delete(&model.MessageEdit{}).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
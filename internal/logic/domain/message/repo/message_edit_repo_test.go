@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"fmt"
+	"gim/internal/logic/domain/message/model"
+	"testing"
+	"time"
+)
+
+func TestMessageEditRepo_Save(t *testing.T) {
+	fmt.Println(MessageEditRepo.Save(&model.MessageEdit{
+		MessageId: 1,
+		Content:   []byte("hello"),
+		SendTime:  time.Now(),
+	}))
+}
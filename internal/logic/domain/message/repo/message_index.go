@@ -0,0 +1,119 @@
+package repo
+
+import (
+	"fmt"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/search"
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	MessageIndexMaxUsers = 10000            // 内存索引最多缓存的用户数量
+	MessageIndexTTL      = 10 * time.Minute // 内存索引的有效期，其他实例写入的消息最晚在有效期之后可以搜索到
+	MessageIndexResetKey = "message_index_reset:%d"
+
+	messageIndexLoadBatch = 1000  // 加载用户消息索引时每次从DB中读取的消息数量
+	MessageIndexMaxDocs   = 10000 // 每个用户最多加载的消息数量，只能搜索到最近的这些消息和之后写入的消息
+)
+
+// MessageIndex 消息全文索引，默认使用内存倒排索引，只包含本实例写入的消息和加载时DB中的消息，
+// 多实例部署时依赖有效期重新加载，需要实时一致时可以在启动时替换成其他实现
+var MessageIndex search.Index = newMessageIndex()
+
+func newMessageIndex() search.Index {
+	index := search.NewMemoryIndex(loadDocuments, MessageIndexMaxUsers, MessageIndexTTL)
+	index.IsStale = isIndexStale
+	return index
+}
+
+// ResetMessageIndex 通知所有实例重新加载用户的消息索引，用于恢复归档等直接修改消息表的场景
+func ResetMessageIndex(userId int64) error {
+	err := db.RedisCli.Set(fmt.Sprintf(MessageIndexResetKey, userId), time.Now().UnixNano(), MessageIndexTTL).Err()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// isIndexStale 用户的消息索引是否在加载之后被重置过，查询失败时认为没有失效
+func isIndexStale(userId int64, loadTime time.Time) bool {
+	value, err := db.RedisCli.Get(fmt.Sprintf(MessageIndexResetKey, userId)).Result()
+	if err == redis.Nil {
+		return false
+	}
+	if err != nil {
+		logger.Logger.Error("get message index reset time error", zap.Int64("user_id", userId), zap.Error(err))
+		return false
+	}
+	resetTime, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false
+	}
+	return resetTime > loadTime.UnixNano()
+}
+
+// loadDocuments 从DB中按序列号倒序分批加载用户正常状态并且没有删除的文本消息，最多加载MessageIndexMaxDocs条，
+// 避免消息很多的用户一次读取所有消息
+func loadDocuments(userId int64) ([]*search.Document, error) {
+	var docs []*search.Document
+	var beforeSeq int64
+	for len(docs) < MessageIndexMaxDocs {
+		DB := db.DB.Table(MessageRepo.tableName(userId)).
+			Select("user_id,seq,message_id,sender_id,receiver_type,receiver_id,type,content,send_time").
+			Where("user_id = ? and type = ? and status = ? and is_deleted = 0", userId, pb.MessageType_MT_TEXT, pb.MessageStatus_MS_NORMAL)
+		if beforeSeq > 0 {
+			DB = DB.Where("seq < ?", beforeSeq)
+		}
+
+		var messages []model.Message
+		err := DB.Order("seq desc").Limit(messageIndexLoadBatch).Find(&messages).Error
+		if err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		for i := range messages {
+			doc := NewDocument(&messages[i])
+			if doc != nil && len(docs) < MessageIndexMaxDocs {
+				docs = append(docs, doc)
+			}
+		}
+		if len(messages) < messageIndexLoadBatch {
+			break
+		}
+		beforeSeq = messages[len(messages)-1].Seq
+	}
+	return docs, nil
+}
+
+// NewDocument 将文本消息转换成索引文档，不是文本消息或者内容无法解析时返回nil
+func NewDocument(message *model.Message) *search.Document {
+	if message.Type != int(pb.MessageType_MT_TEXT) {
+		return nil
+	}
+	var text pb.Text
+	err := proto.Unmarshal(message.Content, &text)
+	if err != nil || text.Text == "" {
+		return nil
+	}
+
+	receiverType, receiverId := message.ConversationOf(message.UserId)
+	return &search.Document{
+		UserId:       message.UserId,
+		Seq:          message.Seq,
+		MessageId:    message.MessageId,
+		ReceiverType: receiverType,
+		ReceiverId:   receiverId,
+		SenderId:     message.SenderId,
+		SendTime:     util.UnixMilliTime(message.SendTime),
+		Text:         text.Text,
+	}
+}
@@ -0,0 +1,56 @@
+package repo
+
+import (
+	"gim/internal/logic/domain/message/model"
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+)
+
+type messageReactionRepo struct{}
+
+var MessageReactionRepo = new(messageReactionRepo)
+
+// Add 添加表情回应，重复添加返回false
+func (*messageReactionRepo) Add(messageId, userId int64, emoji string) (bool, error) {
+	result := db.DB.Exec("insert ignore into message_reaction (message_id,user_id,emoji) values (?,?,?)",
+		messageId, userId, emoji)
+	if result.Error != nil {
+		return false, gerrors.WrapError(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Delete 删除表情回应，回应不存在返回false
+func (*messageReactionRepo) Delete(messageId, userId int64, emoji string) (bool, error) {
+	result := db.DB.Exec("delete from message_reaction where message_id = ? and user_id = ? and emoji = ?",
+		messageId, userId, emoji)
+	if result.Error != nil {
+		return false, gerrors.WrapError(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteByMessageIds 删除消息的所有表情回应
+func (*messageReactionRepo) DeleteByMessageIds(messageIds []int64) error {
+	if len(messageIds) == 0 {
+		return nil
+	}
+	err := db.DB.Where("message_id in (?)", messageIds).Delete(&model.MessageReaction{}).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// ListByMessageIds 批量获取消息的表情回应，按回应时间排序
+func (*messageReactionRepo) ListByMessageIds(messageIds []int64) ([]model.MessageReaction, error) {
+	if len(messageIds) == 0 {
+		return nil, nil
+	}
+	var reactions []model.MessageReaction
+	err := db.DB.Where("message_id in (?)", messageIds).Order("id").Find(&reactions).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return reactions, nil
+}
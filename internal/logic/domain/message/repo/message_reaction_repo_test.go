@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMessageReactionRepo_Add(t *testing.T) {
+	fmt.Println(MessageReactionRepo.Add(1, 1, "👍"))
+}
+
+func TestMessageReactionRepo_Delete(t *testing.T) {
+	fmt.Println(MessageReactionRepo.Delete(1, 1, "👍"))
+}
+
+func TestMessageReactionRepo_ListByMessageIds(t *testing.T) {
+	reactions, err := MessageReactionRepo.ListByMessageIds([]int64{1, 2})
+	fmt.Printf("%+v\n %+v\n", reactions, err)
+}
@@ -0,0 +1,37 @@
+package repo
+
+import (
+	"gim/internal/logic/domain/message/model"
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+
+	"github.com/jinzhu/gorm"
+)
+
+type messageReadRepo struct{}
+
+var MessageReadRepo = new(messageReadRepo)
+
+// Get 获取用户在会话中的已读位置，没有记录返回nil
+func (*messageReadRepo) Get(userId int64, receiverType int32, receiverId int64) (*model.MessageRead, error) {
+	var read model.MessageRead
+	err := db.DB.First(&read, "user_id = ? and receiver_type = ? and receiver_id = ?", userId, receiverType, receiverId).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, gerrors.WrapError(err)
+	}
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &read, nil
+}
+
+// Save 保存用户在会话中的已读位置，已读位置只会前进不会后退
+func (*messageReadRepo) Save(userId int64, receiverType int32, receiverId int64, readSeq int64) error {
+	err := db.DB.Exec("insert into message_read (user_id,receiver_type,receiver_id,read_seq) values (?,?,?,?) "+
+		"on duplicate key update read_seq = greatest(read_seq,values(read_seq))",
+		userId, receiverType, receiverId, readSeq).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
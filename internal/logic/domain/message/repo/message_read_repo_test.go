@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMessageReadRepo_Save(t *testing.T) {
+	fmt.Println(MessageReadRepo.Save(1, 1, 2, 10))
+}
+
+func TestMessageReadRepo_Get(t *testing.T) {
+	read, err := MessageReadRepo.Get(1, 1, 2)
+	fmt.Printf("%+v\n %+v\n", read, err)
+}
@@ -1,35 +1,164 @@
 package repo
 
 import (
-	"fmt"
 	"gim/internal/logic/domain/message/model"
 	"gim/pkg/db"
 	"gim/pkg/gerrors"
-)
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"strings"
+	"time"
 
-const messageTableNum = 1
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+)
 
 type messageRepo struct{}
 
 var MessageRepo = new(messageRepo)
 
+// tableName 读取用户消息使用的消息表
 func (*messageRepo) tableName(userId int64) string {
-	return fmt.Sprintf("message_%03d", userId%messageTableNum)
+	return MessageShardRepo.Get().ReadLayout().UserTableName(userId)
+}
+
+// exec 在用户消息所在的所有需要写入的消息表上执行写操作，重新分表过程中先写当前分表，再写新分表
+func (*messageRepo) exec(userId int64, fn func(DB *gorm.DB) error) error {
+	for _, layout := range MessageShardRepo.Get().WriteLayouts() {
+		err := fn(db.DB.Table(layout.UserTableName(userId)))
+		if err != nil {
+			return gerrors.WrapError(err)
+		}
+	}
+	return nil
+}
+
+// create 插入一条消息，重新分表过程中同时插入新分表，新分表中的主键重新生成，消息已经被复制到新分表时忽略，
+// 新分表不是读取的分表时，插入失败只记录日志，消息已经写入当前分表，切换时会重新复制补齐，
+// 已经切换到从新分表读取时，插入失败返回错误，否则发送者收到成功但是看不到这条消息
+func (*messageRepo) create(message *model.Message) error {
+	shard := MessageShardRepo.Get()
+	readLayout := shard.ReadLayout()
+	for i, layout := range shard.WriteLayouts() {
+		table := layout.UserTableName(message.UserId)
+		if i == 0 {
+			err := db.DB.Table(table).Create(message).Error
+			if err != nil {
+				return gerrors.WrapError(err)
+			}
+			continue
+		}
+
+		copied := *message
+		copied.Id = 0
+		err := db.DB.Table(table).Set("gorm:insert_modifier", "IGNORE").Create(&copied).Error
+		if err != nil {
+			if layout == readLayout {
+				return gerrors.WrapError(err)
+			}
+			logger.Logger.Error("insert message error", zap.String("table", table), zap.Int64("user_id", message.UserId),
+				zap.Int64("seq", message.Seq), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// insertBatch 用一条语句向消息表中插入多条消息，ignore为true时忽略唯一索引冲突
+func (*messageRepo) insertBatch(table string, messages []model.Message, ignore bool) error {
+	var columns []string
+	placeholders := make([]string, 0, len(messages))
+	values := make([]interface{}, 0, len(messages)*32)
+	for i := range messages {
+		var marks []string
+		for _, field := range db.DB.NewScope(&messages[i]).Fields() {
+			if field.IsPrimaryKey || field.IsIgnored || !field.IsNormal {
+				continue
+			}
+			if i == 0 {
+				columns = append(columns, "`"+field.DBName+"`")
+			}
+			marks = append(marks, "?")
+			values = append(values, field.Field.Interface())
+		}
+		placeholders = append(placeholders, "("+strings.Join(marks, ",")+")")
+	}
+
+	modifier := ""
+	if ignore {
+		modifier = " ignore"
+	}
+	sql := "insert" + modifier + " into `" + table + "` (" + strings.Join(columns, ",") + ") values " + strings.Join(placeholders, ",")
+	return db.DB.Exec(sql, values...).Error
+}
+
+// SaveBatch 批量插入消息，同一张消息表的消息用一条语句插入，返回插入失败的用户和原因，一张表插入失败不影响其他表，
+// 重新分表过程中新分表不是读取的分表时，插入失败只记录日志，切换时会重新复制补齐
+func (d *messageRepo) SaveBatch(messages []model.Message) map[int64]error {
+	failed := make(map[int64]error)
+	shard := MessageShardRepo.Get()
+	readLayout := shard.ReadLayout()
+	for i, layout := range shard.WriteLayouts() {
+		tables := make(map[string][]model.Message)
+		for j := range messages {
+			if _, ok := failed[messages[j].UserId]; ok {
+				continue
+			}
+			table := layout.UserTableName(messages[j].UserId)
+			tables[table] = append(tables[table], messages[j])
+		}
+
+		for table, tableMessages := range tables {
+			err := d.insertBatch(table, tableMessages, i > 0)
+			if err == nil {
+				continue
+			}
+			logger.Logger.Error("insert messages error", zap.String("table", table), zap.Error(err))
+			if i == 0 || layout == readLayout {
+				for j := range tableMessages {
+					failed[tableMessages[j].UserId] = gerrors.WrapError(err)
+				}
+			}
+		}
+	}
+
+	// 索引失败不影响消息发送
+	for i := range messages {
+		if _, ok := failed[messages[i].UserId]; ok {
+			continue
+		}
+		doc := NewDocument(&messages[i])
+		if doc != nil {
+			err := MessageIndex.Add(doc)
+			if err != nil {
+				logger.Logger.Error("index message error", zap.Int64("user_id", messages[i].UserId), zap.Error(err))
+			}
+		}
+	}
+	return failed
 }
 
 // Save 插入一条消息
 func (d *messageRepo) Save(message model.Message) error {
-	err := db.DB.Table(d.tableName(message.UserId)).Create(&message).Error
+	err := d.create(&message)
 	if err != nil {
-		return gerrors.WrapError(err)
+		return err
+	}
+
+	// 索引失败不影响消息发送
+	doc := NewDocument(&message)
+	if doc != nil {
+		err = MessageIndex.Add(doc)
+		if err != nil {
+			logger.Logger.Error("index message error", zap.Int64("user_id", message.UserId), zap.Error(err))
+		}
 	}
 	return nil
 }
 
-// ListBySeq 根据类型和id查询大于序号大于seq的消息
+// ListBySeq 根据类型和id查询大于序号大于seq的消息，不包括用户删除的消息
 func (d *messageRepo) ListBySeq(userId, seq, limit int64) ([]model.Message, bool, error) {
 	DB := db.DB.Table(d.tableName(userId)).
-		Where("user_id = ? and seq > ?", userId, seq)
+		Where("user_id = ? and seq > ? and is_deleted = 0", userId, seq)
 
 	var count int64
 	err := DB.Count(&count).Error
@@ -47,3 +176,534 @@ func (d *messageRepo) ListBySeq(userId, seq, limit int64) ([]model.Message, bool
 	}
 	return messages, count > limit, nil
 }
+
+// conversationScope 限定查询用户在会话中未删除的消息，会话类型不支持时返回nil
+func (d *messageRepo) conversationScope(DB *gorm.DB, userId int64, receiverType int32, receiverId int64) *gorm.DB {
+	DB = DB.Where("user_id = ? and is_deleted = 0", userId)
+	switch pb.ReceiverType(receiverType) {
+	case pb.ReceiverType_RT_USER:
+		// 单聊包括自己发给对方的消息和对方发给自己的消息，receiverId为对方用户id
+		return DB.Where("receiver_type = ? and sender_type = ? and ((sender_id = ? and receiver_id = ?) or (sender_id = ? and receiver_id = ?))",
+			receiverType, pb.SenderType_ST_USER, userId, receiverId, receiverId, userId)
+	case pb.ReceiverType_RT_GROUP:
+		return DB.Where("receiver_type = ? and receiver_id = ?", receiverType, receiverId)
+	default:
+		return nil
+	}
+}
+
+// ListHistory 查询用户在会话中序列号小于beforeSeq的消息，按序列号倒序，beforeSeq为0时从最新的消息开始查询
+func (d *messageRepo) ListHistory(userId int64, receiverType int32, receiverId int64, beforeSeq, limit int64) ([]model.Message, bool, error) {
+	DB := d.conversationScope(db.DB.Table(d.tableName(userId)), userId, receiverType, receiverId)
+	if DB == nil {
+		return nil, false, nil
+	}
+	if beforeSeq > 0 {
+		DB = DB.Where("seq < ?", beforeSeq)
+	}
+
+	// 多查询一条，判断是否还有更多数据
+	var messages []model.Message
+	err := DB.Order("seq desc").Limit(limit + 1).Find(&messages).Error
+	if err != nil {
+		return nil, false, gerrors.WrapError(err)
+	}
+	if int64(len(messages)) > limit {
+		return messages[:limit], true, nil
+	}
+	return messages, false, nil
+}
+
+// GetLast 获取用户在会话中序列号小于等于seq的最后一条消息，消息不存在返回nil
+func (d *messageRepo) GetLast(userId int64, receiverType int32, receiverId int64, seq int64) (*model.Message, error) {
+	DB := d.conversationScope(db.DB.Table(d.tableName(userId)), userId, receiverType, receiverId)
+	if DB == nil {
+		return nil, nil
+	}
+
+	var message model.Message
+	err := DB.Where("seq <= ?", seq).Order("seq desc").First(&message).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, gerrors.WrapError(err)
+	}
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &message, nil
+}
+
+// ListBySeqs 根据序列号批量查询用户的消息，不包括用户删除的消息
+func (d *messageRepo) ListBySeqs(userId int64, seqs []int64) ([]model.Message, error) {
+	if len(seqs) == 0 {
+		return nil, nil
+	}
+	var messages []model.Message
+	err := db.DB.Table(d.tableName(userId)).Where("user_id = ? and seq in (?) and is_deleted = 0", userId, seqs).Find(&messages).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return messages, nil
+}
+
+// Get 获取用户的一条消息，消息不存在返回nil
+func (d *messageRepo) Get(userId, seq int64) (*model.Message, error) {
+	var message model.Message
+	err := db.DB.Table(d.tableName(userId)).First(&message, "user_id = ? and seq = ?", userId, seq).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, gerrors.WrapError(err)
+	}
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &message, nil
+}
+
+// GetByMessageId 根据消息id获取用户的一条消息，消息不存在返回nil
+func (d *messageRepo) GetByMessageId(userId, messageId int64) (*model.Message, error) {
+	var message model.Message
+	err := db.DB.Table(d.tableName(userId)).First(&message, "user_id = ? and message_id = ?", userId, messageId).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, gerrors.WrapError(err)
+	}
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	return &message, nil
+}
+
+// ListByMessageIds 根据消息id批量查询用户的消息，按序列号排序，不包括用户删除的消息
+func (d *messageRepo) ListByMessageIds(userId int64, messageIds []int64) ([]model.Message, error) {
+	if len(messageIds) == 0 {
+		return nil, nil
+	}
+	var messages []model.Message
+	err := db.DB.Table(d.tableName(userId)).
+		Where("user_id = ? and message_id in (?) and is_deleted = 0", userId, messageIds).
+		Order("seq").Find(&messages).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return messages, nil
+}
+
+// ListThread 查询用户消息列表中话题序列号小于beforeSeq的回复，按序列号倒序，beforeSeq为0时从最新的回复开始查询
+func (d *messageRepo) ListThread(userId, rootId, beforeSeq, limit int64) ([]model.Message, bool, error) {
+	DB := db.DB.Table(d.tableName(userId)).Where("user_id = ? and thread_root_id = ? and is_deleted = 0", userId, rootId)
+	if beforeSeq > 0 {
+		DB = DB.Where("seq < ?", beforeSeq)
+	}
+
+	// 多查询一条，判断是否还有更多数据
+	var messages []model.Message
+	err := DB.Order("seq desc").Limit(limit + 1).Find(&messages).Error
+	if err != nil {
+		return nil, false, gerrors.WrapError(err)
+	}
+	if int64(len(messages)) > limit {
+		return messages[:limit], true, nil
+	}
+	return messages, false, nil
+}
+
+// ListMentions 查询用户消息列表中@用户的序列号小于beforeSeq的消息，按序列号倒序，groupId为0时查询所有群组
+func (d *messageRepo) ListMentions(userId, groupId, beforeSeq, limit int64) ([]model.Message, bool, error) {
+	DB := db.DB.Table(d.tableName(userId)).Where("user_id = ? and is_mentioned = 1 and is_deleted = 0", userId)
+	if groupId > 0 {
+		DB = DB.Where("receiver_type = ? and receiver_id = ?", pb.ReceiverType_RT_GROUP, groupId)
+	}
+	if beforeSeq > 0 {
+		DB = DB.Where("seq < ?", beforeSeq)
+	}
+
+	// 多查询一条，判断是否还有更多数据
+	var messages []model.Message
+	err := DB.Order("seq desc").Limit(limit + 1).Find(&messages).Error
+	if err != nil {
+		return nil, false, gerrors.WrapError(err)
+	}
+	if int64(len(messages)) > limit {
+		return messages[:limit], true, nil
+	}
+	return messages, false, nil
+}
+
+// IncrReplyCount 话题根消息的回复数加一，并更新最后回复时间
+func (d *messageRepo) IncrReplyCount(userId, rootId int64, replyTime time.Time) error {
+	return d.exec(userId, func(DB *gorm.DB) error {
+		return DB.Where("user_id = ? and message_id = ?", userId, rootId).
+			Updates(map[string]interface{}{
+				"reply_count":     gorm.Expr("reply_count + 1"),
+				"last_reply_time": replyTime,
+			}).Error
+	})
+}
+
+// IncrReplyCountBatch 批量将多个用户消息列表中的话题根消息的回复数加一，并更新最后回复时间
+func (*messageRepo) IncrReplyCountBatch(userIds []int64, rootId int64, replyTime time.Time) error {
+	for _, layout := range MessageShardRepo.Get().WriteLayouts() {
+		tables := make(map[string][]int64)
+		for _, userId := range userIds {
+			table := layout.UserTableName(userId)
+			tables[table] = append(tables[table], userId)
+		}
+
+		for table, tableUserIds := range tables {
+			err := db.DB.Table(table).
+				Where("user_id in (?) and message_id = ?", tableUserIds, rootId).
+				Updates(map[string]interface{}{
+					"reply_count":     gorm.Expr("reply_count + 1"),
+					"last_reply_time": replyTime,
+				}).Error
+			if err != nil {
+				return gerrors.WrapError(err)
+			}
+		}
+	}
+	return nil
+}
+
+// sameMessage 限定查询同一条消息的所有副本
+func (*messageRepo) sameMessage(DB *gorm.DB, message *model.Message) *gorm.DB {
+	if message.MessageId != 0 {
+		return DB.Where("message_id = ?", message.MessageId)
+	}
+	// 没有消息id的历史消息，通过发送者、接收者和发送时间确定同一条消息
+	return DB.Where("sender_type = ? and sender_id = ? and receiver_type = ? and receiver_id = ? and send_time = ?",
+		message.SenderType, message.SenderId, message.ReceiverType, message.ReceiverId, message.SendTime)
+}
+
+// ListUserIds 查询所有持有这条消息副本的用户，包括已经退出群组的用户，不包括消息发送之后加入群组的用户
+func (d *messageRepo) ListUserIds(message *model.Message) ([]int64, error) {
+	var result []int64
+	for _, table := range MessageShardRepo.Get().ReadLayout().TableNames() {
+		var userIds []int64
+		err := d.sameMessage(db.DB.Table(table), message).Pluck("user_id", &userIds).Error
+		if err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		result = append(result, userIds...)
+	}
+	return result, nil
+}
+
+// UpdateStatus 更新用户消息列表中同一条消息的状态
+func (d *messageRepo) UpdateStatus(userId int64, message *model.Message, status int32) error {
+	return d.UpdateStatusBatch([]int64{userId}, message, status)
+}
+
+// UpdateStatusBatch 批量更新多个用户消息列表中同一条消息的状态，同一张消息表的用户用一条语句更新，
+// 一张表更新失败时继续更新其他表，最后返回第一个错误，重新执行可以补齐
+func (d *messageRepo) UpdateStatusBatch(userIds []int64, message *model.Message, status int32) error {
+	var firstErr error
+	for _, layout := range MessageShardRepo.Get().WriteLayouts() {
+		tables := make(map[string][]int64)
+		for _, userId := range userIds {
+			table := layout.UserTableName(userId)
+			tables[table] = append(tables[table], userId)
+		}
+
+		for table, tableUserIds := range tables {
+			DB := db.DB.Table(table).Where("user_id in (?)", tableUserIds)
+			err := d.sameMessage(DB, message).Update("status", status).Error
+			if err != nil {
+				logger.Logger.Error("update message status error", zap.String("table", table), zap.Error(err))
+				if firstErr == nil {
+					firstErr = gerrors.WrapError(err)
+				}
+			}
+		}
+	}
+
+	if status != int32(pb.MessageStatus_MS_NORMAL) && message.MessageId != 0 {
+		for _, userId := range userIds {
+			err := MessageIndex.Delete(userId, []int64{message.MessageId})
+			if err != nil {
+				logger.Logger.Error("delete message index error", zap.Int64("user_id", userId), zap.Error(err))
+			}
+		}
+	}
+	return firstErr
+}
+
+// Delete 从用户的消息列表中删除消息，只删除用户自己的副本
+func (d *messageRepo) Delete(userId int64, messages []model.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	seqs := make([]int64, 0, len(messages))
+	messageIds := make([]int64, 0, len(messages))
+	for i := range messages {
+		seqs = append(seqs, messages[i].Seq)
+		if messages[i].MessageId != 0 {
+			messageIds = append(messageIds, messages[i].MessageId)
+		}
+	}
+
+	err := d.exec(userId, func(DB *gorm.DB) error {
+		return DB.Where("user_id = ? and seq in (?)", userId, seqs).Update("is_deleted", true).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	err = MessageIndex.Delete(userId, messageIds)
+	if err != nil {
+		logger.Logger.Error("delete message index error", zap.Int64("user_id", userId), zap.Error(err))
+	}
+	return nil
+}
+
+// Clear 删除用户在会话中序列号小于等于seq的所有消息，只删除用户自己的副本
+func (d *messageRepo) Clear(userId int64, receiverType int32, receiverId int64, seq int64) error {
+	DB := d.conversationScope(db.DB.Table(d.tableName(userId)), userId, receiverType, receiverId)
+	if DB == nil {
+		return nil
+	}
+
+	var messageIds []int64
+	err := DB.Where("seq <= ? and type = ? and message_id <> 0", seq, pb.MessageType_MT_TEXT).Pluck("message_id", &messageIds).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+
+	err = d.exec(userId, func(DB *gorm.DB) error {
+		return d.conversationScope(DB, userId, receiverType, receiverId).Where("seq <= ?", seq).Update("is_deleted", true).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	err = MessageIndex.Delete(userId, messageIds)
+	if err != nil {
+		logger.Logger.Error("delete message index error", zap.Int64("user_id", userId), zap.Error(err))
+	}
+	return nil
+}
+
+// ExpireRead 用户读取会话中序列号小于等于seq的阅后即焚消息后，将这些消息的销毁时间提前到now，返回这些消息的id，自己发送的消息不受影响
+func (d *messageRepo) ExpireRead(userId int64, receiverType int32, receiverId int64, seq int64, now time.Time) ([]int64, error) {
+	DB := d.conversationScope(db.DB.Table(d.tableName(userId)), userId, receiverType, receiverId)
+	if DB == nil {
+		return nil, nil
+	}
+	where := "seq <= ? and expire_time > ? and not (sender_type = ? and sender_id = ?)"
+	args := []interface{}{seq, now, pb.SenderType_ST_USER, userId}
+
+	var messageIds []int64
+	err := DB.Where(where, args...).Pluck("message_id", &messageIds).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	if len(messageIds) == 0 {
+		return nil, nil
+	}
+
+	err = d.exec(userId, func(DB *gorm.DB) error {
+		return d.conversationScope(DB, userId, receiverType, receiverId).Where(where, args...).Update("expire_time", now).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return messageIds, nil
+}
+
+// ExpireByMessageIds 将用户消息列表中阅后即焚消息的销毁时间提前到now
+func (d *messageRepo) ExpireByMessageIds(userId int64, messageIds []int64, now time.Time) error {
+	return d.exec(userId, func(DB *gorm.DB) error {
+		return DB.Where("user_id = ? and message_id in (?) and expire_time > ?", userId, messageIds, now).
+			Update("expire_time", now).Error
+	})
+}
+
+// ListExpired 查询所有消息表中到了销毁时间的阅后即焚消息，每张表最多查询limit条
+func (d *messageRepo) ListExpired(now time.Time, limit int64) ([]model.Message, error) {
+	var result []model.Message
+	for _, table := range MessageShardRepo.Get().ReadLayout().TableNames() {
+		var messages []model.Message
+		err := db.DB.Table(table).
+			Where("expire_time <= ?", now).Limit(limit).Find(&messages).Error
+		if err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		result = append(result, messages...)
+	}
+	return result, nil
+}
+
+// Destroy 销毁用户的一条阅后即焚消息，清空消息内容，并删除这条消息的索引，
+// 按照查询到的销毁时间更新，多个实例同时销毁同一条消息时只有一个实例返回true
+func (d *messageRepo) Destroy(message *model.Message) (bool, error) {
+	if message.ExpireTime == nil {
+		return false, nil
+	}
+
+	updates := map[string]interface{}{
+		"content":     []byte{},
+		"status":      pb.MessageStatus_MS_DESTROYED,
+		"expire_time": gorm.Expr("null"),
+	}
+	for i, layout := range MessageShardRepo.Get().WriteLayouts() {
+		DB := db.DB.Table(layout.UserTableName(message.UserId)).Where("user_id = ? and seq = ?", message.UserId, message.Seq)
+		if i > 0 {
+			err := DB.Updates(updates).Error
+			if err != nil {
+				return false, gerrors.WrapError(err)
+			}
+			continue
+		}
+
+		result := DB.Where("expire_time = ?", *message.ExpireTime).Updates(updates)
+		if result.Error != nil {
+			return false, gerrors.WrapError(result.Error)
+		}
+		// 已经被其他实例销毁，或者销毁时间被修改
+		if result.RowsAffected == 0 {
+			return false, nil
+		}
+	}
+
+	if message.MessageId != 0 {
+		err := MessageIndex.Delete(message.UserId, []int64{message.MessageId})
+		if err != nil {
+			logger.Logger.Error("delete message index error", zap.Int64("user_id", message.UserId), zap.Error(err))
+		}
+	}
+	return true, nil
+}
+
+// TableNames 读取消息使用的所有消息表
+func (*messageRepo) TableNames() []string {
+	return MessageShardRepo.Get().ReadLayout().TableNames()
+}
+
+// ListBefore 按照主键顺序查询消息表中发送时间早于before的消息，
+// messageType为0时查询除了excludeTypes之外的所有消息类型
+func (d *messageRepo) ListBefore(table string, receiverType, messageType int32, excludeTypes []int32,
+	before time.Time, limit int64) ([]model.Message, error) {
+	DB := db.DB.Table(table).Where("receiver_type = ? and send_time < ?", receiverType, before)
+	if messageType != 0 {
+		DB = DB.Where("type = ?", messageType)
+	} else if len(excludeTypes) > 0 {
+		DB = DB.Where("type not in (?)", excludeTypes)
+	}
+
+	var messages []model.Message
+	err := DB.Order("id").Limit(limit).Find(&messages).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return messages, nil
+}
+
+// Purge 从消息表中物理删除消息，同时删除消息的编辑历史、表情回应和索引，
+// 同一条消息的所有副本发送时间相同，会被同一次清理删除，第一个副本删除时就删除编辑历史和表情回应
+func (d *messageRepo) Purge(messages []model.Message) error {
+	userSeqs := make(map[int64][]int64)
+	userMessageIds := make(map[int64][]int64)
+	var messageIds []int64
+	for i := range messages {
+		userSeqs[messages[i].UserId] = append(userSeqs[messages[i].UserId], messages[i].Seq)
+		if messages[i].MessageId != 0 {
+			userMessageIds[messages[i].UserId] = append(userMessageIds[messages[i].UserId], messages[i].MessageId)
+			messageIds = append(messageIds, messages[i].MessageId)
+		}
+	}
+
+	for userId, seqs := range userSeqs {
+		err := d.exec(userId, func(DB *gorm.DB) error {
+			return DB.Where("user_id = ? and seq in (?)", userId, seqs).Delete(model.Message{}).Error
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := MessageEditRepo.DeleteByMessageIds(messageIds)
+	if err != nil {
+		return err
+	}
+	err = MessageReactionRepo.DeleteByMessageIds(messageIds)
+	if err != nil {
+		return err
+	}
+
+	for userId, messageIds := range userMessageIds {
+		err := MessageIndex.Delete(userId, messageIds)
+		if err != nil {
+			logger.Logger.Error("delete message index error", zap.Int64("user_id", userId), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// Restore 恢复一条归档的消息，用户消息列表中已经存在相同序列号的消息时不恢复，
+// 只写入DB，全文索引由调用方通过ResetMessageIndex通知各个实例重新加载
+func (d *messageRepo) Restore(message model.Message) (bool, error) {
+	exist, err := d.Get(message.UserId, message.Seq)
+	if err != nil {
+		return false, err
+	}
+	if exist != nil {
+		return false, nil
+	}
+
+	// 主键重新生成，避免和清理之后新插入的消息冲突
+	message.Id = 0
+	err = d.create(&message)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdateContent 更新用户消息列表中同一条消息的内容，并重建这条消息的索引
+func (d *messageRepo) UpdateContent(userId, messageId int64, content []byte, editTime time.Time) error {
+	err := d.exec(userId, func(DB *gorm.DB) error {
+		return DB.Where("user_id = ? and message_id = ?", userId, messageId).
+			Updates(map[string]interface{}{
+				"content":   content,
+				"edit_time": editTime,
+			}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	message, err := d.GetByMessageId(userId, messageId)
+	if err != nil || message == nil {
+		return err
+	}
+	doc := NewDocument(message)
+	if doc != nil {
+		err = MessageIndex.Add(doc)
+		if err != nil {
+			logger.Logger.Error("index message error", zap.Int64("user_id", userId), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// CountUnread 统计用户在会话中序列号大于seq的未读消息数，指令消息、撤回的消息、销毁的消息、删除的消息和自己发送的消息不计入未读
+func (d *messageRepo) CountUnread(userId int64, receiverType int32, receiverId int64, seq int64) (int64, error) {
+	DB := db.DB.Table(d.tableName(userId)).
+		Where("user_id = ? and seq > ? and is_deleted = 0 and type <> ? and status not in (?)",
+			userId, seq, pb.MessageType_MT_COMMAND, []pb.MessageStatus{pb.MessageStatus_MS_RECALL, pb.MessageStatus_MS_DESTROYED})
+	switch pb.ReceiverType(receiverType) {
+	case pb.ReceiverType_RT_USER:
+		// 单聊中对方发给自己的消息，receiverId为对方用户id
+		DB = DB.Where("receiver_type = ? and sender_type = ? and sender_id = ? and receiver_id = ?",
+			receiverType, pb.SenderType_ST_USER, receiverId, userId)
+	case pb.ReceiverType_RT_GROUP:
+		DB = DB.Where("receiver_type = ? and receiver_id = ? and not (sender_type = ? and sender_id = ?)",
+			receiverType, receiverId, pb.SenderType_ST_USER, userId)
+	default:
+		return 0, nil
+	}
+
+	var count int64
+	err := DB.Count(&count).Error
+	if err != nil {
+		return 0, gerrors.WrapError(err)
+	}
+	return count, nil
+}
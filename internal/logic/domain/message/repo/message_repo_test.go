@@ -19,8 +19,10 @@ func TestMessageDao_Add(t *testing.T) {
 		Type:         1,
 		Content:      []byte("123456"),
 		Seq:          2,
+		MessageId:    1,
 		SendTime:     time.Now(),
 		Status:       0,
+		CreateTime:   time.Now(),
 	}
 	fmt.Println(MessageRepo.Save(message))
 }
@@ -34,6 +36,76 @@ func TestMessageDao_ListByUserIdAndUserSeq(t *testing.T) {
 	}
 }
 
+func TestMessageRepo_ListHistory(t *testing.T) {
+	messages, hasMore, err := MessageRepo.ListHistory(1, 1, 2, 0, 20)
+	fmt.Println(err)
+	fmt.Println(hasMore)
+	for i := range messages {
+		fmt.Printf("%+v\n", messages[i])
+	}
+}
+
+func TestMessageRepo_Get(t *testing.T) {
+	message, err := MessageRepo.Get(1, 2)
+	fmt.Printf("%+v\n %+v\n", message, err)
+}
+
+func TestMessageRepo_GetLast(t *testing.T) {
+	message, err := MessageRepo.GetLast(1, 1, 2, 100)
+	fmt.Printf("%+v\n %+v\n", message, err)
+}
+
+func TestMessageRepo_ListUserIds(t *testing.T) {
+	fmt.Println(MessageRepo.ListUserIds(&model.Message{MessageId: 1}))
+}
+
+func TestMessageRepo_GetByMessageId(t *testing.T) {
+	message, err := MessageRepo.GetByMessageId(1, 1)
+	fmt.Printf("%+v\n %+v\n", message, err)
+}
+
+func TestMessageRepo_ListThread(t *testing.T) {
+	messages, hasMore, err := MessageRepo.ListThread(1, 1, 0, 20)
+	fmt.Println(err)
+	fmt.Println(hasMore)
+	for i := range messages {
+		fmt.Printf("%+v\n", messages[i])
+	}
+}
+
+func TestMessageRepo_SaveBatch(t *testing.T) {
+	messages := []model.Message{
+		{UserId: 1, SenderType: 1, SenderId: 1, ReceiverType: 2, ReceiverId: 1, Type: 1, Content: []byte("123456"), Seq: 100, MessageId: 100, SendTime: time.Now(), CreateTime: time.Now()},
+		{UserId: 2, SenderType: 1, SenderId: 1, ReceiverType: 2, ReceiverId: 1, Type: 1, Content: []byte("123456"), Seq: 100, MessageId: 100, SendTime: time.Now(), CreateTime: time.Now()},
+	}
+	fmt.Println(MessageRepo.SaveBatch(messages))
+}
+
+func TestMessageRepo_ListMentions(t *testing.T) {
+	messages, hasMore, err := MessageRepo.ListMentions(1, 0, 0, 20)
+	fmt.Println(err)
+	fmt.Println(hasMore)
+	for i := range messages {
+		fmt.Printf("%+v\n", messages[i])
+	}
+}
+
+func TestMessageRepo_ListExpired(t *testing.T) {
+	messages, err := MessageRepo.ListExpired(time.Now(), 100)
+	fmt.Println(err)
+	for i := range messages {
+		fmt.Printf("%+v\n", messages[i])
+	}
+}
+
+func TestMessageRepo_ListBefore(t *testing.T) {
+	messages, err := MessageRepo.ListBefore("message_000", 2, 0, []int32{2}, time.Now().AddDate(0, -1, 0), 100)
+	fmt.Println(err)
+	for i := range messages {
+		fmt.Printf("%+v\n", messages[i])
+	}
+}
+
 func Test_messageDao_tableName(t *testing.T) {
 	fmt.Println(MessageRepo.tableName(1001))
 }
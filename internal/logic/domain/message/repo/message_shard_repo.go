@@ -0,0 +1,235 @@
+package repo
+
+import (
+	"fmt"
+	"gim/config"
+	"gim/internal/logic/domain/message/model"
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/util"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
+)
+
+const (
+	messageShardId     = 1                   // 分表配置只有一行
+	MessageShardAckKey = "message_shard_ack" // 各个实例加载的分表配置状态，field为实例标识，value为"状态|上报时间"
+)
+
+type messageShardRepo struct {
+	shard atomic.Value // *model.MessageShard
+}
+
+var MessageShardRepo = new(messageShardRepo)
+
+// defaultShard 数据库中没有分表配置时，使用配置文件中的分表数量，第一次加载时保存到数据库
+func defaultShard() *model.MessageShard {
+	tableNum := config.Logic.MessageTableNum
+	if tableNum <= 0 {
+		tableNum = 1
+	}
+	return &model.MessageShard{Id: messageShardId, TableNum: tableNum}
+}
+
+// Get 获取缓存的分表配置
+func (r *messageShardRepo) Get() *model.MessageShard {
+	shard, ok := r.shard.Load().(*model.MessageShard)
+	if !ok {
+		return defaultShard()
+	}
+	return shard
+}
+
+// GetFromDB 从数据库中获取最新的分表配置，数据库中没有分表配置时保存配置文件中的分表数量，
+// 之后以数据库中的分表配置为准，修改配置文件中的分表数量不会把用户路由到新的空表
+func (*messageShardRepo) GetFromDB() (*model.MessageShard, error) {
+	var shard model.MessageShard
+	err := db.DB.First(&shard, "id = ?", messageShardId).Error
+	if err == gorm.ErrRecordNotFound {
+		// 多个实例同时第一次启动时只有一个实例保存成功，其他实例读取保存的配置
+		now := time.Now()
+		shard = *defaultShard()
+		shard.CreateTime = now
+		shard.UpdateTime = now
+		err = db.DB.Set("gorm:insert_modifier", "IGNORE").Create(&shard).Error
+		if err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		err = db.DB.First(&shard, "id = ?", messageShardId).Error
+	}
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return &shard, nil
+}
+
+// Load 从数据库中重新加载分表配置
+func (r *messageShardRepo) Load() error {
+	shard, err := r.GetFromDB()
+	if err != nil {
+		return err
+	}
+	if shard.Status == model.ShardStatusNormal && shard.TableNum != config.Logic.MessageTableNum {
+		logger.Logger.Warn("message table num in config is ignored, use reshard to change it",
+			zap.Int("config", config.Logic.MessageTableNum), zap.Int("db", shard.TableNum))
+	}
+	r.shard.Store(shard)
+	return nil
+}
+
+// Start 加载分表配置，并且定时重新加载，重新分表时所有实例在一个加载间隔内切换到新的读写方式
+func (r *messageShardRepo) Start() error {
+	err := r.Load()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(config.Logic.MessageShardReloadInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			func() {
+				defer util.RecoverPanic()
+				err := r.Load()
+				if err != nil {
+					logger.Logger.Error("reload message shard error", zap.Error(err))
+				}
+				// 加载失败时上报的仍然是旧的状态，重新分表工具会等待这个实例
+				err = r.Ack()
+				if err != nil {
+					logger.Logger.Error("ack message shard error", zap.Error(err))
+				}
+			}()
+		}
+	}()
+	return r.Ack()
+}
+
+// instanceId 当前实例的标识
+func instanceId() string {
+	hostname, _ := os.Hostname()
+	return hostname + ":" + strconv.Itoa(os.Getpid())
+}
+
+// Ack 上报当前实例加载的分表配置状态
+func (r *messageShardRepo) Ack() error {
+	value := r.Get().State() + "|" + strconv.FormatInt(util.UnixMilliTime(time.Now()), 10)
+	err := db.RedisCli.HSet(MessageShardAckKey, instanceId(), value).Err()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// ListAcks 获取所有存活的实例加载的分表配置状态，超过3个加载间隔没有上报的实例认为已经下线
+func (*messageShardRepo) ListAcks() (map[string]string, error) {
+	values, err := db.RedisCli.HGetAll(MessageShardAckKey).Result()
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+
+	deadline := util.UnixMilliTime(time.Now().Add(-3 * config.Logic.MessageShardReloadInterval))
+	acks := make(map[string]string, len(values))
+	for instance, value := range values {
+		i := strings.LastIndex(value, "|")
+		if i < 0 {
+			continue
+		}
+		reportTime, _ := strconv.ParseInt(value[i+1:], 10, 64)
+		if reportTime < deadline {
+			db.RedisCli.HDel(MessageShardAckKey, instance)
+			continue
+		}
+		acks[instance] = value[:i]
+	}
+	return acks, nil
+}
+
+// Save 保存分表配置
+func (*messageShardRepo) Save(shard *model.MessageShard) error {
+	shard.Id = messageShardId
+	err := db.DB.Save(shard).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// ShowCreateTable 获取表的建表语句
+func (*messageShardRepo) ShowCreateTable(table string) (string, error) {
+	var name, ddl string
+	err := db.DB.Raw("show create table `"+table+"`").Row().Scan(&name, &ddl)
+	if err != nil {
+		return "", gerrors.WrapError(err)
+	}
+	return ddl, nil
+}
+
+// CreateTableLike 按照模板表的结构创建表，表已经存在时不做处理
+func (*messageShardRepo) CreateTableLike(table, template string) error {
+	err := db.DB.Exec("create table if not exists `" + table + "` like `" + template + "`").Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// MaxId 表中最大的主键
+func (*messageShardRepo) MaxId(table string) (int64, error) {
+	var maxId int64
+	err := db.DB.Raw("select ifnull(max(id), 0) from `" + table + "`").Row().Scan(&maxId)
+	if err != nil {
+		return 0, gerrors.WrapError(err)
+	}
+	return maxId, nil
+}
+
+// columns 表中除了主键之外的所有列
+func (*messageShardRepo) columns(table string) ([]string, error) {
+	rows, err := db.DB.Raw("select * from `" + table + "` limit 0").Rows()
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	result := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if column != "id" {
+			result = append(result, "`"+column+"`")
+		}
+	}
+	return result, nil
+}
+
+// Copy 将旧表中主键在(minId, maxId]之间的消息复制到新分表中，主键由新表重新生成，
+// 已经双写到新分表中的消息通过唯一索引(user_id, seq)忽略，返回复制的消息数量
+func (r *messageShardRepo) Copy(from string, to model.MessageLayout, minId, maxId int64) (int64, error) {
+	columns, err := r.columns(from)
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Join(columns, ",")
+
+	var count int64
+	for i := 0; i < to.TableNum; i++ {
+		sql := fmt.Sprintf("insert ignore into `%s` (%s) select %s from `%s` where id > ? and id <= ? and user_id %% ? = ?",
+			to.TableName(i), fields, fields, from)
+		result := db.DB.Exec(sql, minId, maxId, to.TableNum, i)
+		if result.Error != nil {
+			return 0, gerrors.WrapError(result.Error)
+		}
+		count += result.RowsAffected
+	}
+	return count, nil
+}
@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMessageShardRepo_GetFromDB(t *testing.T) {
+	shard, err := MessageShardRepo.GetFromDB()
+	fmt.Printf("%+v\n %+v\n", shard, err)
+}
+
+func TestMessageShardRepo_ShowCreateTable(t *testing.T) {
+	fmt.Println(MessageShardRepo.ShowCreateTable("message_000"))
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"gim/pkg/db"
 	"gim/pkg/gerrors"
+	"strings"
 )
 
 const (
@@ -41,3 +42,53 @@ func (*seqRepo) Incr(objectType int, objectId int64) (int64, error) {
 	tx.Commit()
 	return seq + 1, nil
 }
+
+// IncrBatch 批量自增多个对象的seq，并且获取自增后的值，在一个事务中完成，
+// objectIds需要按照相同的顺序传入，避免并发批量自增时死锁
+func (*seqRepo) IncrBatch(objectType int, objectIds []int64) (map[int64]int64, error) {
+	seqs := make(map[int64]int64, len(objectIds))
+	if len(objectIds) == 0 {
+		return seqs, nil
+	}
+
+	placeholders := make([]string, len(objectIds))
+	values := make([]interface{}, 0, 2*len(objectIds))
+	for i := range objectIds {
+		placeholders[i] = "(?,?,1)"
+		values = append(values, objectType, objectIds[i])
+	}
+
+	tx := db.DB.Begin()
+	defer tx.Rollback()
+
+	err := tx.Exec("insert into seq (object_type,object_id,seq) values "+strings.Join(placeholders, ",")+
+		" on duplicate key update seq = seq + 1", values...).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+
+	// 事务提交之前其他事务无法修改这些行，查询到的就是本次自增后的值
+	rows, err := tx.Raw("select object_id,seq from seq where object_type = ? and object_id in (?)", objectType, objectIds).Rows()
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var objectId, seq int64
+		err = rows.Scan(&objectId, &seq)
+		if err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		seqs[objectId] = seq
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return seqs, nil
+}
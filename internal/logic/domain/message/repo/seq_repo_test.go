@@ -8,3 +8,7 @@ import (
 func Test_seqDao_Incr(t *testing.T) {
 	fmt.Println(SeqRepo.Incr(1, 5))
 }
+
+func Test_seqDao_IncrBatch(t *testing.T) {
+	fmt.Println(SeqRepo.IncrBatch(1, []int64{5, 6, 7}))
+}
@@ -0,0 +1,30 @@
+package repo
+
+import (
+	"fmt"
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+	"time"
+)
+
+const SignalRateKey = "signal_rate:%d:%d"
+
+type signalRateRepo struct{}
+
+var SignalRateRepo = new(signalRateRepo)
+
+// Incr 用户在当前秒内发送的瞬时信号数量加一，返回加一后的数量
+func (*signalRateRepo) Incr(userId int64) (int64, error) {
+	key := fmt.Sprintf(SignalRateKey, userId, time.Now().Unix())
+	count, err := db.RedisCli.Incr(key).Result()
+	if err != nil {
+		return 0, gerrors.WrapError(err)
+	}
+	if count == 1 {
+		err = db.RedisCli.Expire(key, 2*time.Second).Err()
+		if err != nil {
+			return 0, gerrors.WrapError(err)
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,10 @@
+package repo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSignalRateRepo_Incr(t *testing.T) {
+	fmt.Println(SignalRateRepo.Incr(1))
+}
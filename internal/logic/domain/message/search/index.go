@@ -0,0 +1,52 @@
+package search
+
+// Document 被索引的文本消息，ReceiverType和ReceiverId是消息在用户消息列表中所属的会话
+type Document struct {
+	UserId       int64  // 消息所属用户id
+	Seq          int64  // 消息序列号
+	MessageId    int64  // 消息id
+	ReceiverType int32  // 会话类型
+	ReceiverId   int64  // 单聊为对方用户id，群聊为群组id
+	SenderId     int64  // 发送者id
+	SendTime     int64  // 消息发送时间戳，精确到毫秒
+	Text         string // 文本内容
+}
+
+// Query 搜索条件，除了UserId和Keyword，其他条件为零值时不做过滤
+type Query struct {
+	UserId       int64  // 用户id
+	Keyword      string // 关键词
+	ReceiverType int32  // 会话类型
+	ReceiverId   int64  // 单聊为对方用户id，群聊为群组id
+	SenderId     int64  // 发送者id
+	StartTime    int64  // 开始时间戳，精确到毫秒
+	EndTime      int64  // 结束时间戳，精确到毫秒
+	Limit        int    // 返回的最大数量
+}
+
+// Match 判断文档是否满足除关键词以外的过滤条件
+func (q *Query) Match(doc *Document) bool {
+	if q.ReceiverType != 0 && (doc.ReceiverType != q.ReceiverType || doc.ReceiverId != q.ReceiverId) {
+		return false
+	}
+	if q.SenderId != 0 && doc.SenderId != q.SenderId {
+		return false
+	}
+	if q.StartTime != 0 && doc.SendTime < q.StartTime {
+		return false
+	}
+	if q.EndTime != 0 && doc.SendTime > q.EndTime {
+		return false
+	}
+	return true
+}
+
+// Index 消息全文索引，默认使用内存倒排索引，可以替换成Elasticsearch等外部实现
+type Index interface {
+	// Add 添加文档，同一用户同一序列号的文档重复添加会覆盖
+	Add(doc *Document) error
+	// Delete 删除用户的消息
+	Delete(userId int64, messageIds []int64) error
+	// Search 搜索用户的消息，按序列号倒序返回
+	Search(query *Query) ([]*Document, error)
+}
@@ -0,0 +1,278 @@
+package search
+
+import (
+	"container/list"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Loader 加载用户已经持久化的文本消息，第一次搜索某个用户的消息时调用，用于服务重启后重建索引
+type Loader func(userId int64) ([]*Document, error)
+
+// StaleChecker 判断在loadTime加载的用户索引是否已经失效，用于在多个实例之间通知索引失效
+type StaleChecker func(userId int64, loadTime time.Time) bool
+
+type userIndex struct {
+	userId   int64
+	mutex    sync.RWMutex
+	loaded   chan struct{}                 // 加载完成后关闭
+	err      error                         // 加载失败的原因
+	loadTime time.Time                     // 开始加载的时间
+	deleted  map[int64]struct{}            // 加载过程中删除的消息id，加载完成后过滤掉
+	element  *list.Element                 // 在LRU链表中的位置
+	docs     map[int64]*Document           // 序列号到文档的映射
+	postings map[string]map[int64]struct{} // 词到序列号集合的映射
+}
+
+// MemoryIndex 纯Go实现的内存倒排索引，按用户分别建立索引。
+// 只有搜索时才会从DB加载用户的索引，加载之后本实例写入的消息实时更新到索引中，
+// 按照LRU淘汰最久没有搜索的用户，加载超过ttl之后重新加载。
+// 多实例部署时，其他实例写入的消息在重新加载之后才能搜索到，需要实时一致时替换成Elasticsearch等外部实现
+type MemoryIndex struct {
+	mutex    sync.Mutex
+	loader   Loader
+	maxUsers int
+	ttl      time.Duration
+	users    map[int64]*userIndex
+	lru      *list.List // 最近搜索的用户在前面
+
+	IsStale StaleChecker // 可以为nil
+}
+
+// NewMemoryIndex 创建内存索引，loader可以为nil，maxUsers为最多缓存的用户数量，ttl为用户索引的有效期
+func NewMemoryIndex(loader Loader, maxUsers int, ttl time.Duration) *MemoryIndex {
+	return &MemoryIndex{
+		loader:   loader,
+		maxUsers: maxUsers,
+		ttl:      ttl,
+		users:    make(map[int64]*userIndex),
+		lru:      list.New(),
+	}
+}
+
+func newUserIndex(userId int64) *userIndex {
+	return &userIndex{
+		userId:   userId,
+		loaded:   make(chan struct{}),
+		loadTime: time.Now(),
+		deleted:  make(map[int64]struct{}),
+		docs:     make(map[int64]*Document),
+		postings: make(map[string]map[int64]struct{}),
+	}
+}
+
+// loadedUser 获取已经加载或者正在加载的用户索引，不存在时返回nil，不会触发加载
+func (m *MemoryIndex) loadedUser(userId int64) *userIndex {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.users[userId]
+}
+
+// getUser 获取用户的索引，不存在或者已经过期时创建新的索引，needLoad表示调用方需要负责加载
+func (m *MemoryIndex) getUser(userId int64) (index *userIndex, needLoad bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	index, ok := m.users[userId]
+	if ok && !m.expired(index) {
+		m.lru.MoveToFront(index.element)
+		return index, false
+	}
+	if ok {
+		m.removeUser(index)
+	}
+
+	index = newUserIndex(userId)
+	index.element = m.lru.PushFront(index)
+	m.users[userId] = index
+	for m.maxUsers > 0 && m.lru.Len() > m.maxUsers {
+		m.removeUser(m.lru.Back().Value.(*userIndex))
+	}
+	return index, true
+}
+
+// expired 用户索引是否已经超过有效期
+func (m *MemoryIndex) expired(index *userIndex) bool {
+	return m.ttl > 0 && time.Since(index.loadTime) > m.ttl
+}
+
+// invalidate 移除失效的用户索引
+func (m *MemoryIndex) invalidate(index *userIndex) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.removeUser(index)
+}
+
+// removeUser 从缓存中移除用户索引，调用方需要持有m.mutex
+func (m *MemoryIndex) removeUser(index *userIndex) {
+	if m.users[index.userId] == index {
+		delete(m.users, index.userId)
+	}
+	m.lru.Remove(index.element)
+}
+
+// load 加载用户索引，不持有全局锁，加载过程中写入的消息直接更新到索引中
+func (m *MemoryIndex) load(index *userIndex) {
+	var docs []*Document
+	var err error
+	if m.loader != nil {
+		docs, err = m.loader(index.userId)
+	}
+
+	index.mutex.Lock()
+	if err == nil {
+		for i := range docs {
+			if _, ok := index.deleted[docs[i].MessageId]; ok {
+				continue
+			}
+			// 加载过程中写入的消息比DB中的数据更新
+			if _, ok := index.docs[docs[i].Seq]; ok {
+				continue
+			}
+			index.add(docs[i])
+		}
+	}
+	index.err = err
+	index.deleted = nil
+	index.mutex.Unlock()
+	close(index.loaded)
+
+	if err != nil {
+		m.invalidate(index)
+	}
+}
+
+// Add 添加文档，用户的索引还没有加载时不做处理，加载时会从DB中读取
+func (m *MemoryIndex) Add(doc *Document) error {
+	index := m.loadedUser(doc.UserId)
+	if index == nil {
+		return nil
+	}
+
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	index.add(doc)
+	return nil
+}
+
+// Delete 删除用户的消息
+func (m *MemoryIndex) Delete(userId int64, messageIds []int64) error {
+	index := m.loadedUser(userId)
+	if index == nil {
+		// 还没有加载的用户，加载时会从DB中过滤掉已经删除的消息
+		return nil
+	}
+
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	ids := make(map[int64]struct{}, len(messageIds))
+	for _, id := range messageIds {
+		ids[id] = struct{}{}
+		if index.deleted != nil {
+			index.deleted[id] = struct{}{}
+		}
+	}
+	for seq, doc := range index.docs {
+		if _, ok := ids[doc.MessageId]; ok {
+			index.remove(seq)
+		}
+	}
+	return nil
+}
+
+// Search 搜索用户的消息
+func (m *MemoryIndex) Search(query *Query) ([]*Document, error) {
+	tokens := queryTokens(query.Keyword)
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	index, needLoad := m.getUser(query.UserId)
+	// IsStale可能访问外部存储，不能在全局锁内调用
+	if !needLoad && m.IsStale != nil && m.IsStale(index.userId, index.loadTime) {
+		m.invalidate(index)
+		index, needLoad = m.getUser(query.UserId)
+	}
+	if needLoad {
+		m.load(index)
+	}
+	<-index.loaded
+	if index.err != nil {
+		return nil, index.err
+	}
+
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+
+	// 从最短的倒排链开始求交集
+	sort.Slice(tokens, func(i, j int) bool {
+		return len(index.postings[tokens[i]]) < len(index.postings[tokens[j]])
+	})
+	keyword := strings.ToLower(query.Keyword)
+	checkText := strings.IndexFunc(keyword, isHan) >= 0
+	var docs []*Document
+	for seq := range index.postings[tokens[0]] {
+		if !index.containsAll(seq, tokens[1:]) {
+			continue
+		}
+		doc := index.docs[seq]
+		// 中日韩文字按相邻两字切分，需要再校验原文是否包含完整的关键词
+		if checkText && !strings.Contains(strings.ToLower(doc.Text), keyword) {
+			continue
+		}
+		if !query.Match(doc) {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].Seq > docs[j].Seq
+	})
+	if query.Limit > 0 && len(docs) > query.Limit {
+		docs = docs[:query.Limit]
+	}
+	return docs, nil
+}
+
+func (u *userIndex) add(doc *Document) {
+	if _, ok := u.docs[doc.Seq]; ok {
+		u.remove(doc.Seq)
+	}
+	u.docs[doc.Seq] = doc
+	for _, token := range tokenize(doc.Text) {
+		seqs, ok := u.postings[token]
+		if !ok {
+			seqs = make(map[int64]struct{})
+			u.postings[token] = seqs
+		}
+		seqs[doc.Seq] = struct{}{}
+	}
+}
+
+func (u *userIndex) remove(seq int64) {
+	doc, ok := u.docs[seq]
+	if !ok {
+		return
+	}
+	for _, token := range tokenize(doc.Text) {
+		seqs := u.postings[token]
+		delete(seqs, seq)
+		if len(seqs) == 0 {
+			delete(u.postings, token)
+		}
+	}
+	delete(u.docs, seq)
+}
+
+func (u *userIndex) containsAll(seq int64, tokens []string) bool {
+	for _, token := range tokens {
+		if _, ok := u.postings[token][seq]; !ok {
+			return false
+		}
+	}
+	return true
+}
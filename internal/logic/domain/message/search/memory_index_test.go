@@ -0,0 +1,200 @@
+package search
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func testLoader(userId int64) ([]*Document, error) {
+	return []*Document{
+		{UserId: userId, Seq: 1, MessageId: 11, ReceiverType: 1, ReceiverId: 2, SenderId: 2, SendTime: 1000, Text: "Hello World"},
+	}, nil
+}
+
+func newTestIndex() *MemoryIndex {
+	index := NewMemoryIndex(testLoader, 0, 0)
+	// 只有搜索过的用户才会更新索引
+	_, _ = index.Search(&Query{UserId: 1, Keyword: "hello"})
+	_, _ = index.Search(&Query{UserId: 2, Keyword: "hello"})
+	_ = index.Add(&Document{UserId: 1, Seq: 2, MessageId: 12, ReceiverType: 1, ReceiverId: 2, SenderId: 1, SendTime: 2000, Text: "今天天气很好"})
+	_ = index.Add(&Document{UserId: 1, Seq: 3, MessageId: 13, ReceiverType: 2, ReceiverId: 5, SenderId: 3, SendTime: 3000, Text: "明天天气怎么样, hello"})
+	_ = index.Add(&Document{UserId: 2, Seq: 1, MessageId: 12, ReceiverType: 1, ReceiverId: 1, SenderId: 1, SendTime: 2000, Text: "今天天气很好"})
+	return index
+}
+
+func seqs(docs []*Document) []int64 {
+	var result []int64
+	for _, doc := range docs {
+		result = append(result, doc.Seq)
+	}
+	return result
+}
+
+func equal(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMemoryIndex_Search(t *testing.T) {
+	index := newTestIndex()
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []int64
+	}{
+		{"english word", Query{UserId: 1, Keyword: "HELLO"}, []int64{3, 1}},
+		{"chinese bigram", Query{UserId: 1, Keyword: "天气"}, []int64{3, 2}},
+		{"chinese phrase", Query{UserId: 1, Keyword: "今天天气"}, []int64{2}},
+		{"chinese not contiguous", Query{UserId: 1, Keyword: "天气很样"}, nil},
+		{"single chinese char", Query{UserId: 1, Keyword: "好"}, []int64{2}},
+		{"conversation filter", Query{UserId: 1, Keyword: "天气", ReceiverType: 2, ReceiverId: 5}, []int64{3}},
+		{"sender filter", Query{UserId: 1, Keyword: "hello", SenderId: 2}, []int64{1}},
+		{"time filter", Query{UserId: 1, Keyword: "天气", StartTime: 1500, EndTime: 2500}, []int64{2}},
+		{"limit", Query{UserId: 1, Keyword: "hello", Limit: 1}, []int64{3}},
+		{"other user", Query{UserId: 2, Keyword: "天气"}, []int64{1}},
+		{"empty keyword", Query{UserId: 1, Keyword: " "}, nil},
+	}
+	for _, tt := range tests {
+		docs, err := index.Search(&tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := seqs(docs); !equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryIndex_Delete(t *testing.T) {
+	index := newTestIndex()
+
+	err := index.Delete(1, []int64{13})
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs, err := index.Search(&Query{UserId: 1, Keyword: "天气"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := seqs(docs); !equal(got, []int64{2}) {
+		t.Errorf("got %v, want [2]", got)
+	}
+
+	// 重复添加同一个序列号会覆盖旧的内容
+	_ = index.Add(&Document{UserId: 1, Seq: 2, MessageId: 12, Text: "改过的内容"})
+	docs, _ = index.Search(&Query{UserId: 1, Keyword: "天气"})
+	if len(docs) != 0 {
+		t.Errorf("got %v, want empty", seqs(docs))
+	}
+}
+
+func TestMemoryIndex_AddNotLoaded(t *testing.T) {
+	var loads int
+	index := NewMemoryIndex(func(userId int64) ([]*Document, error) {
+		loads++
+		return testLoader(userId)
+	}, 0, 0)
+
+	// 没有搜索过的用户不加载也不更新索引，搜索时从DB加载
+	_ = index.Add(&Document{UserId: 1, Seq: 2, MessageId: 12, Text: "hello again"})
+	if loads != 0 {
+		t.Fatalf("loads = %d, want 0", loads)
+	}
+	docs, _ := index.Search(&Query{UserId: 1, Keyword: "hello"})
+	if got := seqs(docs); !equal(got, []int64{1}) {
+		t.Errorf("got %v, want [1]", got)
+	}
+
+	_ = index.Add(&Document{UserId: 1, Seq: 2, MessageId: 12, Text: "hello again"})
+	docs, _ = index.Search(&Query{UserId: 1, Keyword: "hello"})
+	if got := seqs(docs); !equal(got, []int64{2, 1}) {
+		t.Errorf("got %v, want [2 1]", got)
+	}
+	if loads != 1 {
+		t.Errorf("loads = %d, want 1", loads)
+	}
+}
+
+func TestMemoryIndex_Evict(t *testing.T) {
+	var loads int
+	index := NewMemoryIndex(func(userId int64) ([]*Document, error) {
+		loads++
+		return testLoader(userId)
+	}, 2, 0)
+
+	for _, userId := range []int64{1, 2, 1, 3, 1} {
+		_, _ = index.Search(&Query{UserId: userId, Keyword: "hello"})
+	}
+	// 用户2最久没有搜索，被淘汰
+	if loads != 3 || len(index.users) != 2 || index.users[2] != nil {
+		t.Fatalf("loads = %d, users = %d", loads, len(index.users))
+	}
+	_, _ = index.Search(&Query{UserId: 2, Keyword: "hello"})
+	if loads != 4 || index.users[3] != nil {
+		t.Errorf("loads = %d, want 4", loads)
+	}
+}
+
+func TestMemoryIndex_Expire(t *testing.T) {
+	var loads int
+	var stale bool
+	index := NewMemoryIndex(func(userId int64) ([]*Document, error) {
+		loads++
+		return testLoader(userId)
+	}, 0, time.Hour)
+	index.IsStale = func(userId int64, loadTime time.Time) bool {
+		return stale
+	}
+
+	_, _ = index.Search(&Query{UserId: 1, Keyword: "hello"})
+	_, _ = index.Search(&Query{UserId: 1, Keyword: "hello"})
+	if loads != 1 {
+		t.Fatalf("loads = %d, want 1", loads)
+	}
+
+	stale = true
+	_, _ = index.Search(&Query{UserId: 1, Keyword: "hello"})
+	if loads != 2 {
+		t.Fatalf("loads = %d, want 2", loads)
+	}
+
+	stale = false
+	index.users[1].loadTime = time.Now().Add(-2 * time.Hour)
+	_, _ = index.Search(&Query{UserId: 1, Keyword: "hello"})
+	if loads != 3 {
+		t.Errorf("loads = %d, want 3", loads)
+	}
+}
+
+func TestMemoryIndex_LoadError(t *testing.T) {
+	fail := true
+	index := NewMemoryIndex(func(userId int64) ([]*Document, error) {
+		if fail {
+			return nil, errors.New("load error")
+		}
+		return testLoader(userId)
+	}, 0, 0)
+
+	_, err := index.Search(&Query{UserId: 1, Keyword: "hello"})
+	if err == nil {
+		t.Fatal("want error")
+	}
+	// 加载失败不缓存，下次搜索重新加载
+	fail = false
+	docs, err := index.Search(&Query{UserId: 1, Keyword: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := seqs(docs); !equal(got, []int64{1}) {
+		t.Errorf("got %v, want [1]", got)
+	}
+}
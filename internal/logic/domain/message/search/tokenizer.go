@@ -0,0 +1,63 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// tokenize 建索引时分词，英文和数字按单词切分，中日韩文字按单字和相邻两字切分
+func tokenize(text string) []string {
+	return split(text, true)
+}
+
+// queryTokens 搜索时分词，中日韩文字只有一个字时使用单字，否则只使用相邻两字，减少倒排链的求交次数
+func queryTokens(keyword string) []string {
+	return split(keyword, false)
+}
+
+func split(text string, withUnigram bool) []string {
+	var (
+		tokens []string
+		word   []rune
+		han    []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushHan := func() {
+		for i := range han {
+			if withUnigram || len(han) == 1 {
+				tokens = append(tokens, string(han[i]))
+			}
+			if i+1 < len(han) {
+				tokens = append(tokens, string(han[i:i+2]))
+			}
+		}
+		han = han[:0]
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isHan(r):
+			flushWord()
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushHan()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushHan()
+		}
+	}
+	flushWord()
+	flushHan()
+	return tokens
+}
+
+func isHan(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"gim/config"
+	"gim/internal/logic/domain/message/validator"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/rpc"
+
+	"go.uber.org/zap"
+)
+
+type beforeSendService struct{}
+
+var BeforeSendService = new(beforeSendService)
+
+// needCallback 是否需要回调业务服务，只有用户发送的配置中的消息类型需要回调
+func (*beforeSendService) needCallback(sender *pb.Sender, messageType pb.MessageType) bool {
+	if sender.SenderType != pb.SenderType_ST_USER {
+		return false
+	}
+	for _, t := range config.Logic.BeforeSendMessageTypes {
+		if t == int32(messageType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check 消息发送前回调业务服务，业务服务可以拒绝发送、修改消息内容或者设置附加字段，修改直接作用在req上
+func (s *beforeSendService) Check(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) error {
+	if !s.needCallback(sender, req.MessageType) {
+		return nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, config.Logic.BeforeSendMessageTimeout)
+	defer cancel()
+	resp, err := rpc.BusinessIntClient.BeforeSendMessage(callCtx, &pb.BeforeSendMessageReq{
+		Sender:  sender,
+		Message: req,
+	})
+	if err != nil {
+		logger.Logger.Error("before send message callback error", zap.Int64("sender_id", sender.SenderId),
+			zap.Bool("fail_open", config.Logic.BeforeSendMessageFailOpen), zap.Error(err))
+		if config.Logic.BeforeSendMessageFailOpen {
+			return nil
+		}
+		return gerrors.ErrSendRejected
+	}
+
+	if resp.IsReject {
+		return gerrors.NewSendRejected(resp.Reason)
+	}
+	if len(resp.MessageContent) > 0 {
+		// 业务服务修改后的内容同样需要校验
+		err = validator.Validate(req.MessageType, resp.MessageContent)
+		if err != nil {
+			return err
+		}
+		req.MessageContent = resp.MessageContent
+	}
+	if resp.Extra != "" {
+		err = validator.ValidateExtra(resp.Extra)
+		if err != nil {
+			logger.Logger.Error("before send message callback extra too long", zap.Int64("sender_id", sender.SenderId),
+				zap.Int("len", len(resp.Extra)))
+			return err
+		}
+		req.Extra = resp.Extra
+	}
+	return nil
+}
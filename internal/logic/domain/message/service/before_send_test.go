@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"gim/config"
+	"gim/pkg/pb"
+	"testing"
+)
+
+func TestBeforeSendService_needCallback(t *testing.T) {
+	old := config.Logic.BeforeSendMessageTypes
+	defer func() { config.Logic.BeforeSendMessageTypes = old }()
+	config.Logic.BeforeSendMessageTypes = []int32{int32(pb.MessageType_MT_TEXT)}
+
+	user := &pb.Sender{SenderType: pb.SenderType_ST_USER, SenderId: 1}
+	if !BeforeSendService.needCallback(user, pb.MessageType_MT_TEXT) {
+		t.Fatal("text message from user should callback")
+	}
+	if BeforeSendService.needCallback(user, pb.MessageType_MT_IMAGE) {
+		t.Fatal("image message should not callback")
+	}
+	if BeforeSendService.needCallback(&pb.Sender{SenderType: pb.SenderType_ST_BUSINESS}, pb.MessageType_MT_TEXT) {
+		t.Fatal("message from business should not callback")
+	}
+
+	// 不需要回调时直接放行，不会调用业务服务
+	err := BeforeSendService.Check(context.TODO(), user, &pb.SendMessageReq{MessageType: pb.MessageType_MT_IMAGE})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"gim/internal/logic/domain/conversation"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/repo"
+	"gim/internal/logic/proxy"
+	"gim/pkg/grpclib"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/rpc"
+	"sort"
+
+	"go.uber.org/zap"
+)
+
+const SendBatchSize = 500 // 批量写扩散每批处理的最大用户数量
+
+// SendToUsers 批量发送消息给多个用户，每批用户批量获取序列号、按消息表批量插入消息、批量更新会话、
+// 按connect节点批量投递，返回发送失败的用户id，单个用户失败不影响其他用户
+func (s *messageService) SendToUsers(ctx context.Context, messageId int64, sender *pb.Sender, toUserIds []int64, req *pb.SendMessageReq) []int64 {
+	// 按照相同的顺序处理用户，避免并发批量更新同一批行时死锁
+	userIds := make([]int64, len(toUserIds))
+	copy(userIds, toUserIds)
+	sort.Slice(userIds, func(i, j int) bool { return userIds[i] < userIds[j] })
+
+	var failed []int64
+	for start := 0; start < len(userIds); start += SendBatchSize {
+		end := start + SendBatchSize
+		if end > len(userIds) {
+			end = len(userIds)
+		}
+		failed = append(failed, s.sendBatch(ctx, messageId, sender, userIds[start:end], req)...)
+	}
+	return failed
+}
+
+// sendBatch 发送消息给一批用户，返回发送失败的用户id
+func (s *messageService) sendBatch(ctx context.Context, messageId int64, sender *pb.Sender, userIds []int64, req *pb.SendMessageReq) []int64 {
+	seqs := make(map[int64]int64, len(userIds))
+	var failed []int64
+	if req.IsPersist {
+		var err error
+		seqs, err = SeqService.GetUsersNext(ctx, userIds)
+		if err != nil {
+			logger.Logger.Error("get users next seq error", zap.Int64("message_id", messageId), zap.Error(err))
+			return userIds
+		}
+
+		messages := make([]model.Message, 0, len(userIds))
+		for _, userId := range userIds {
+			message, err := newMessage(ctx, messageId, sender, userId, seqs[userId], req)
+			if err != nil {
+				return userIds
+			}
+			messages = append(messages, message)
+		}
+
+		errs := repo.MessageRepo.SaveBatch(messages)
+		saved := make([]model.Message, 0, len(messages))
+		for i := range messages {
+			if _, ok := errs[messages[i].UserId]; ok {
+				failed = append(failed, messages[i].UserId)
+				continue
+			}
+			saved = append(saved, messages[i])
+		}
+		s.afterSaveBatch(ctx, saved)
+
+		userIds = make([]int64, 0, len(saved))
+		for i := range saved {
+			userIds = append(userIds, saved[i].UserId)
+		}
+	}
+
+	s.deliverBatch(ctx, messageId, sender, userIds, seqs, req)
+	return failed
+}
+
+// afterSaveBatch 消息保存之后，批量更新话题根消息的回复数和会话的最后一条消息，失败不影响消息发送
+func (*messageService) afterSaveBatch(ctx context.Context, messages []model.Message) {
+	if len(messages) == 0 {
+		return
+	}
+
+	if messages[0].ThreadRootId != 0 {
+		userIds := make([]int64, 0, len(messages))
+		for i := range messages {
+			userIds = append(userIds, messages[i].UserId)
+		}
+		err := repo.MessageRepo.IncrReplyCountBatch(userIds, messages[0].ThreadRootId, messages[0].SendTime)
+		if err != nil {
+			logger.Logger.Error("incr reply count batch error", zap.Int64("message_id", messages[0].MessageId), zap.Error(err))
+		}
+	}
+
+	lastMessages := make([]conversation.LastMessage, 0, len(messages))
+	for i := range messages {
+		last, ok := lastMessage(messages[i].UserId, &messages[i])
+		if ok {
+			lastMessages = append(lastMessages, last)
+		}
+	}
+	err := conversation.ConversationService.UpdateLastMessages(ctx, lastMessages)
+	if err != nil {
+		logger.Logger.Error("update conversations error", zap.Int64("message_id", messages[0].MessageId), zap.Error(err))
+	}
+}
+
+// deliverBatch 批量查询用户的在线设备，同一个connect节点上的设备的消息合并成一次调用投递，投递失败不影响消息发送
+func (*messageService) deliverBatch(ctx context.Context, messageId int64, sender *pb.Sender, userIds []int64, seqs map[int64]int64, req *pb.SendMessageReq) {
+	if len(userIds) == 0 {
+		return
+	}
+	userDevices, err := proxy.DeviceProxy.ListOnlineByUserIds(ctx, userIds)
+	if err != nil {
+		logger.Logger.Error("list online devices error", zap.Int64("message_id", messageId), zap.Error(err))
+		return
+	}
+
+	connMessages := make(map[string][]*pb.DeliverMessageReq)
+	for _, userId := range userIds {
+		devices := userDevices[userId]
+		if len(devices) == 0 {
+			continue
+		}
+		message := newPBMessage(messageId, sender, userId, seqs[userId], req)
+		for i := range devices {
+			// 消息不需要投递给发送消息的设备
+			if sender.DeviceId == devices[i].DeviceId {
+				continue
+			}
+			connMessages[devices[i].ConnAddr] = append(connMessages[devices[i].ConnAddr], &pb.DeliverMessageReq{
+				DeviceId:    devices[i].DeviceId,
+				MessageSend: &pb.MessageSend{Message: message},
+			})
+		}
+	}
+
+	for connAddr, messages := range connMessages {
+		_, err = rpc.ConnectIntClient.DeliverMessages(grpclib.ContextWithAddr(ctx, connAddr), &pb.DeliverMessagesReq{Messages: messages})
+		if err != nil {
+			logger.Logger.Error("deliver messages error", zap.String("conn_addr", connAddr), zap.Int("count", len(messages)), zap.Error(err))
+		}
+	}
+}
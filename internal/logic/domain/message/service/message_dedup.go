@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"gim/config"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// MessageSendingDuration 消息正在发送的标记的有效期，需要大于一次发送的最长耗时，
+// 实例在发送过程中退出时，客户端最多等待这个时长就可以重试
+const MessageSendingDuration = 30 * time.Second
+
+type messageDedupService struct{}
+
+var MessageDedupService = new(messageDedupService)
+
+// Begin 开始发送一条带客户端唯一标识的消息，如果这条消息已经发送成功，返回之前的发送结果
+func (*messageDedupService) Begin(ctx context.Context, sender *pb.Sender, clientKey string) (*pb.SendMessageResp, error) {
+	ok, err := repo.MessageDedupRepo.Acquire(sender.SenderType, sender.SenderId, clientKey, MessageSendingDuration)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return nil, nil
+	}
+
+	resp, err := repo.MessageDedupRepo.Get(sender.SenderType, sender.SenderId, clientKey)
+	if err != nil {
+		return nil, err
+	}
+	// 上一次请求还没有处理完成
+	if resp == nil {
+		return nil, gerrors.ErrMessageSending
+	}
+	return resp, nil
+}
+
+// Finish 消息发送结束，成功时保存发送结果，并且把有效期延长到整个去重时间窗口，失败时resp为nil，删除标记，允许客户端重试
+func (*messageDedupService) Finish(ctx context.Context, sender *pb.Sender, clientKey string, resp *pb.SendMessageResp) {
+	var err error
+	if resp != nil {
+		err = repo.MessageDedupRepo.Set(sender.SenderType, sender.SenderId, clientKey, resp, config.Logic.MessageDedupDuration)
+	} else {
+		err = repo.MessageDedupRepo.Del(sender.SenderType, sender.SenderId, clientKey)
+	}
+	if err != nil {
+		logger.Logger.Error("finish message dedup error", zap.String("client_message_key", clientKey), zap.Error(err))
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"gim/internal/logic/domain/conversation"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+
+	"go.uber.org/zap"
+)
+
+type messageDeleteService struct{}
+
+var MessageDeleteService = new(messageDeleteService)
+
+// Delete 从用户的消息列表中删除消息，重新计算相关会话的未读数，并同步给用户的其他设备
+func (*messageDeleteService) Delete(ctx context.Context, userId int64, seqs []int64) error {
+	messages, err := repo.MessageRepo.ListBySeqs(userId, seqs)
+	if err != nil {
+		return err
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	err = repo.MessageRepo.Delete(userId, messages)
+	if err != nil {
+		return err
+	}
+
+	type conversationKey struct {
+		receiverType int32
+		receiverId   int64
+	}
+	keys := make(map[conversationKey]struct{})
+	deletedSeqs := make([]int64, 0, len(messages))
+	for i := range messages {
+		receiverType, receiverId := messages[i].ConversationOf(userId)
+		keys[conversationKey{receiverType, receiverId}] = struct{}{}
+		deletedSeqs = append(deletedSeqs, messages[i].Seq)
+	}
+	for key := range keys {
+		err = MessageReadService.RefreshUnreadCount(userId, key.receiverType, key.receiverId)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = PushService.PushToUser(ctx, userId, pb.PushCode_PC_DELETE_MESSAGES, &pb.DeleteMessagesPush{Seqs: deletedSeqs}, true)
+	if err != nil {
+		logger.Logger.Error("push delete messages error", zap.Int64("user_id", userId), zap.Error(err))
+	}
+	return nil
+}
+
+// Clear 清空用户消息列表中会话的所有消息，并同步给用户的其他设备
+func (*messageDeleteService) Clear(ctx context.Context, userId int64, receiverType pb.ReceiverType, receiverId int64) error {
+	messages, _, err := repo.MessageRepo.ListHistory(userId, int32(receiverType), receiverId, 0, 1)
+	if err != nil {
+		return err
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	// 只清空当前最新的消息以及之前的消息，清空过程中新收到的消息不受影响
+	seq := messages[0].Seq
+	err = repo.MessageRepo.Clear(userId, int32(receiverType), receiverId, seq)
+	if err != nil {
+		return err
+	}
+	err = MessageReadService.RefreshUnreadCount(userId, int32(receiverType), receiverId)
+	if err != nil {
+		return err
+	}
+	err = conversation.ConversationRepo.ClearMention(userId, int32(receiverType), receiverId, seq)
+	if err != nil {
+		return err
+	}
+
+	err = PushService.PushToUser(ctx, userId, pb.PushCode_PC_CLEAR_CONVERSATION, &pb.ClearConversationPush{
+		ReceiverType: receiverType,
+		ReceiverId:   receiverId,
+		Seq:          seq,
+	}, true)
+	if err != nil {
+		logger.Logger.Error("push clear conversation error", zap.Int64("user_id", userId), zap.Error(err))
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"gim/config"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type messageEditService struct{}
+
+var MessageEditService = new(messageEditService)
+
+// GetEditMessage 获取用户要编辑的消息，并检查用户是否有权限编辑
+func (*messageEditService) GetEditMessage(ctx context.Context, userId, seq int64) (*model.Message, error) {
+	message, err := repo.MessageRepo.Get(userId, seq)
+	if err != nil {
+		return nil, err
+	}
+	if message == nil {
+		return nil, gerrors.ErrMessageNotFound
+	}
+	// 编辑通过消息id更新所有副本，没有消息id的历史消息不支持编辑
+	if message.MessageId == 0 {
+		return nil, gerrors.ErrBadRequest
+	}
+
+	err = message.CheckEdit(userId, config.Logic.MessageEditDuration)
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// Edit 编辑消息，保存编辑前的内容，更新所有持有这条消息副本的用户消息列表中的这条消息，并只推送给这些用户，
+// 阅后即焚消息销毁时只清空消息表中的内容，不保存编辑前的内容
+func (*messageEditService) Edit(ctx context.Context, message *model.Message, content []byte, userIds []int64) error {
+	var text pb.Text
+	err := proto.Unmarshal(content, &text)
+	if err != nil || text.Text == "" {
+		return gerrors.ErrBadRequest
+	}
+
+	if message.Ttl == 0 {
+		versionTime := message.SendTime
+		if message.EditTime != nil {
+			versionTime = *message.EditTime
+		}
+		err = repo.MessageEditRepo.Save(&model.MessageEdit{
+			MessageId: message.MessageId,
+			Content:   message.Content,
+			SendTime:  versionTime,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	editTime := time.Now()
+	for _, userId := range userIds {
+		err := repo.MessageRepo.UpdateContent(userId, message.MessageId, content, editTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	push := &pb.EditMessagePush{
+		OptId:          message.SenderId,
+		ReceiverType:   pb.ReceiverType(message.ReceiverType),
+		ReceiverId:     message.ReceiverId,
+		MessageId:      message.MessageId,
+		MessageContent: content,
+		EditTime:       util.UnixMilliTime(editTime),
+	}
+	for _, userId := range userIds {
+		err := PushService.PushToUser(ctx, userId, pb.PushCode_PC_EDIT_MESSAGE, push, true)
+		if err != nil {
+			logger.Logger.Error("push edit message error", zap.Int64("user_id", userId), zap.Error(err))
+		}
+	}
+	return nil
+}
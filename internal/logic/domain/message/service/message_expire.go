@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const expireLimit = 100 // 每次扫描每张消息表的最大阅后即焚消息数量
+
+type messageExpireService struct{}
+
+var MessageExpireService = new(messageExpireService)
+
+// ExpireRead 用户读取会话后，销毁会话中已读的阅后即焚消息，单聊同时销毁发送者的副本，
+// 群聊中阅后即焚是按读取者计算的，只销毁读取者自己的副本，其他成员和发送者的副本在各自读取或者超过时长后销毁
+func (*messageExpireService) ExpireRead(ctx context.Context, userId int64, receiverType pb.ReceiverType, receiverId, seq int64) error {
+	now := time.Now()
+	messageIds, err := repo.MessageRepo.ExpireRead(userId, int32(receiverType), receiverId, seq, now)
+	if err != nil {
+		return err
+	}
+	if len(messageIds) == 0 || receiverType != pb.ReceiverType_RT_USER {
+		return nil
+	}
+	return repo.MessageRepo.ExpireByMessageIds(receiverId, messageIds, now)
+}
+
+// Expire 销毁所有到了销毁时间的阅后即焚消息，并通知消息所属的用户，多个实例同时扫描时，只有销毁成功的实例通知用户
+func (*messageExpireService) Expire(ctx context.Context) error {
+	messages, err := repo.MessageRepo.ListExpired(time.Now(), expireLimit)
+	if err != nil {
+		return err
+	}
+
+	for i := range messages {
+		ok, err := repo.MessageRepo.Destroy(&messages[i])
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		receiverType, receiverId := messages[i].ConversationOf(messages[i].UserId)
+		err = PushService.PushToUser(ctx, messages[i].UserId, pb.PushCode_PC_DESTROY_MESSAGE, &pb.DestroyMessagePush{
+			ReceiverType: pb.ReceiverType(receiverType),
+			ReceiverId:   receiverId,
+			Seq:          messages[i].Seq,
+			MessageId:    messages[i].MessageId,
+		}, true)
+		if err != nil {
+			logger.Logger.Error("push destroy message error", zap.Int64("user_id", messages[i].UserId), zap.Error(err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type messageForwardService struct{}
+
+var MessageForwardService = new(messageForwardService)
+
+// ListForwardMessages 获取用户要转发的消息，用户只能转发自己消息列表中能看到的消息，按发送顺序返回
+func (*messageForwardService) ListForwardMessages(ctx context.Context, userId int64, messageIds []int64) ([]model.Message, error) {
+	ids := make(map[int64]struct{}, len(messageIds))
+	for _, messageId := range messageIds {
+		ids[messageId] = struct{}{}
+	}
+
+	messages, err := repo.MessageRepo.ListByMessageIds(userId, messageIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) != len(ids) {
+		return nil, gerrors.ErrMessageNotFound
+	}
+	for i := range messages {
+		if messages[i].Type == int(pb.MessageType_MT_COMMAND) {
+			return nil, gerrors.ErrBadRequest
+		}
+		if messages[i].Status == int32(pb.MessageStatus_MS_RECALL) {
+			return nil, gerrors.ErrMessageRecalled
+		}
+		// 阅后即焚消息不能转发
+		if messages[i].Status == int32(pb.MessageStatus_MS_DESTROYED) || messages[i].Ttl > 0 {
+			return nil, gerrors.ErrBadRequest
+		}
+	}
+	return messages, nil
+}
+
+// NewMerge 将消息合并成一条聊天记录，返回聊天记录的消息内容
+func (*messageForwardService) NewMerge(ctx context.Context, title string, messages []model.Message) ([]byte, error) {
+	pbMessages := model.MessagesToPB(messages)
+	err := MessageService.AddMessagesSenderInfo(ctx, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	merge := &pb.Merge{
+		Title: title,
+		Items: make([]*pb.MergeItem, 0, len(pbMessages)),
+	}
+	for _, message := range pbMessages {
+		merge.Items = append(merge.Items, &pb.MergeItem{
+			Sender:         message.Sender,
+			MessageType:    message.MessageType,
+			MessageContent: message.MessageContent,
+			SendTime:       message.SendTime,
+		})
+	}
+	content, err := proto.Marshal(merge)
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return content, nil
+}
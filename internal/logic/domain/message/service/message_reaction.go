@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+
+	"go.uber.org/zap"
+)
+
+const MaxEmojiLen = 64 // 表情的最大字节数
+
+type messageReactionService struct{}
+
+var MessageReactionService = new(messageReactionService)
+
+// GetMessage 获取用户要回应的消息，只能回应自己消息列表中的消息
+func (*messageReactionService) GetMessage(ctx context.Context, userId, seq int64) (*model.Message, error) {
+	message, err := repo.MessageRepo.Get(userId, seq)
+	if err != nil {
+		return nil, err
+	}
+	if message == nil {
+		return nil, gerrors.ErrMessageNotFound
+	}
+	// 表情回应通过消息id关联到同一条消息的所有副本，没有消息id的历史消息不支持回应
+	if message.MessageId == 0 || message.Type == int(pb.MessageType_MT_COMMAND) {
+		return nil, gerrors.ErrBadRequest
+	}
+	if message.Status == int32(pb.MessageStatus_MS_RECALL) {
+		return nil, gerrors.ErrMessageRecalled
+	}
+	if message.Status == int32(pb.MessageStatus_MS_DESTROYED) {
+		return nil, gerrors.ErrMessageNotFound
+	}
+	return message, nil
+}
+
+// Add 添加表情回应，并推送给会话中的所有用户
+func (s *messageReactionService) Add(ctx context.Context, userId int64, message *model.Message, emoji string, userIds []int64) error {
+	added, err := repo.MessageReactionRepo.Add(message.MessageId, userId, emoji)
+	if err != nil {
+		return err
+	}
+	if !added {
+		return nil
+	}
+	return s.push(ctx, userId, message, emoji, true, userIds)
+}
+
+// Remove 取消表情回应，并推送给会话中的所有用户
+func (s *messageReactionService) Remove(ctx context.Context, userId int64, message *model.Message, emoji string, userIds []int64) error {
+	deleted, err := repo.MessageReactionRepo.Delete(message.MessageId, userId, emoji)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return nil
+	}
+	return s.push(ctx, userId, message, emoji, false, userIds)
+}
+
+// push 推送消息最新的表情回应汇总，客户端离线期间的变化通过同步消息时带回的汇总获取，所以不需要持久化
+func (*messageReactionService) push(ctx context.Context, userId int64, message *model.Message, emoji string, isAdd bool, userIds []int64) error {
+	reactions, err := repo.MessageReactionRepo.ListByMessageIds([]int64{message.MessageId})
+	if err != nil {
+		return err
+	}
+
+	push := &pb.MessageReactionPush{
+		OptId:        userId,
+		ReceiverType: pb.ReceiverType(message.ReceiverType),
+		ReceiverId:   message.ReceiverId,
+		MessageId:    message.MessageId,
+		Emoji:        emoji,
+		IsAdd:        isAdd,
+		Reactions:    model.ReactionsToPB(reactions)[message.MessageId],
+	}
+	for _, userId := range userIds {
+		err := PushService.PushToUser(ctx, userId, pb.PushCode_PC_MESSAGE_REACTION, push, false)
+		if err != nil {
+			logger.Logger.Error("push message reaction error", zap.Int64("user_id", userId), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// AddMessagesReactions 批量补充消息的表情回应汇总
+func (*messageReactionService) AddMessagesReactions(messages []*pb.Message) error {
+	messageIds := make([]int64, 0, len(messages))
+	for i := range messages {
+		if messages[i].MessageId != 0 && messages[i].MessageType != pb.MessageType_MT_COMMAND {
+			messageIds = append(messageIds, messages[i].MessageId)
+		}
+	}
+	if len(messageIds) == 0 {
+		return nil
+	}
+
+	reactions, err := repo.MessageReactionRepo.ListByMessageIds(messageIds)
+	if err != nil {
+		return err
+	}
+	summaries := model.ReactionsToPB(reactions)
+	for i := range messages {
+		messages[i].Reactions = summaries[messages[i].MessageId]
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"gim/internal/logic/domain/conversation"
+	"gim/internal/logic/domain/friend"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type messageReadService struct{}
+
+var MessageReadService = new(messageReadService)
+
+// MarkRead 设置用户在会话中的已读位置，同步给用户的其他设备，单聊还需要给对方发送已读回执，
+// 已读位置修正为会话中序列号小于等于seq的最后一条消息，避免客户端把已读位置设置到不存在的消息之后
+func (*messageReadService) MarkRead(ctx context.Context, userId int64, receiverType pb.ReceiverType, receiverId, seq int64) error {
+	message, err := repo.MessageRepo.GetLast(userId, int32(receiverType), receiverId, seq)
+	if err != nil {
+		return err
+	}
+	if message == nil {
+		return nil
+	}
+	seq = message.Seq
+
+	read, err := repo.MessageReadRepo.Get(userId, int32(receiverType), receiverId)
+	if err != nil {
+		return err
+	}
+	// 已读位置只会前进
+	if read != nil && read.ReadSeq >= seq {
+		return nil
+	}
+
+	err = repo.MessageReadRepo.Save(userId, int32(receiverType), receiverId, seq)
+	if err != nil {
+		return err
+	}
+
+	// 已读之后重新计算会话的未读数
+	count, err := repo.MessageRepo.CountUnread(userId, int32(receiverType), receiverId, seq)
+	if err != nil {
+		return err
+	}
+	err = conversation.ConversationRepo.UpdateUnreadCount(userId, int32(receiverType), receiverId, count)
+	if err != nil {
+		return err
+	}
+	err = conversation.ConversationRepo.ClearMention(userId, int32(receiverType), receiverId, seq)
+	if err != nil {
+		return err
+	}
+
+	// 已读的阅后即焚消息交给后台任务销毁
+	err = MessageExpireService.ExpireRead(ctx, userId, receiverType, receiverId, seq)
+	if err != nil {
+		return err
+	}
+
+	// 同步给用户的其他设备
+	err = PushService.PushToUser(ctx, userId, pb.PushCode_PC_SYNC_READ, &pb.SyncReadPush{
+		ReceiverType: receiverType,
+		ReceiverId:   receiverId,
+		ReadSeq:      seq,
+	}, true)
+	if err != nil {
+		logger.Logger.Error("push sync read error", zap.Int64("user_id", userId), zap.Error(err))
+	}
+
+	if receiverType != pb.ReceiverType_RT_USER {
+		return nil
+	}
+
+	// 只给好友发送已读回执
+	f, err := friend.FriendRepo.Get(userId, receiverId)
+	if err != nil {
+		return err
+	}
+	if f == nil || f.Status != friend.FriendStatusAgree {
+		return nil
+	}
+
+	// 对方的消息序列号和自己的不同，回执中使用消息id
+	if message.MessageId == 0 {
+		return nil
+	}
+	err = PushService.PushToUser(ctx, receiverId, pb.PushCode_PC_READ_RECEIPT, &pb.ReadReceiptPush{
+		UserId:    userId,
+		MessageId: message.MessageId,
+		ReadTime:  util.UnixMilliTime(time.Now()),
+	}, true)
+	if err != nil {
+		logger.Logger.Error("push read receipt error", zap.Int64("user_id", receiverId), zap.Error(err))
+	}
+	return nil
+}
+
+// GetUnreadCount 获取用户在会话中的已读位置和未读数
+func (*messageReadService) GetUnreadCount(ctx context.Context, userId int64, receiverType pb.ReceiverType, receiverId int64) (*pb.GetUnreadCountResp, error) {
+	read, err := repo.MessageReadRepo.Get(userId, int32(receiverType), receiverId)
+	if err != nil {
+		return nil, err
+	}
+
+	var readSeq int64
+	if read != nil {
+		readSeq = read.ReadSeq
+	}
+	count, err := repo.MessageRepo.CountUnread(userId, int32(receiverType), receiverId, readSeq)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUnreadCountResp{ReadSeq: readSeq, UnreadCount: count}, nil
+}
+
+// RefreshUnreadCount 根据已读位置重新计算会话的未读数
+func (s *messageReadService) RefreshUnreadCount(userId int64, receiverType int32, receiverId int64) error {
+	resp, err := s.GetUnreadCount(context.TODO(), userId, pb.ReceiverType(receiverType), receiverId)
+	if err != nil {
+		return err
+	}
+	return conversation.ConversationRepo.UpdateUnreadCount(userId, receiverType, receiverId, resp.UnreadCount)
+}
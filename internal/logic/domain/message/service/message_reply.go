@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+)
+
+type messageReplyService struct{}
+
+var MessageReplyService = new(messageReplyService)
+
+// FillReplyTo 根据发送者消息列表中被引用的消息，填写引用回复的快照，不信任客户端传来的快照内容
+func (*messageReplyService) FillReplyTo(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) error {
+	if req.ReplyTo == nil || req.ReplyTo.MessageId == 0 {
+		req.ReplyTo = nil
+		return nil
+	}
+	// 只有用户可以引用回复，需要确认用户能看到被引用的消息
+	if sender.SenderType != pb.SenderType_ST_USER {
+		return gerrors.ErrBadRequest
+	}
+	if req.ReplyTo.IsThread && req.ReceiverType != pb.ReceiverType_RT_GROUP {
+		return gerrors.ErrBadRequest
+	}
+
+	message, err := repo.MessageRepo.GetByMessageId(sender.SenderId, req.ReplyTo.MessageId)
+	if err != nil {
+		return err
+	}
+	if message == nil || message.Type == int(pb.MessageType_MT_COMMAND) {
+		return gerrors.ErrMessageNotFound
+	}
+	// 只能引用同一个会话中的消息
+	receiverType, receiverId := message.ConversationOf(sender.SenderId)
+	if receiverType != int32(req.ReceiverType) || receiverId != req.ReceiverId {
+		return gerrors.ErrBadRequest
+	}
+
+	req.ReplyTo = message.ReplyToPB(req.ReplyTo.IsThread)
+	return nil
+}
+
+// RefreshReplyTo 用被引用消息的当前状态更新引用快照，被引用的消息撤回或者销毁之后不再下发快照中的内容，编辑之后下发编辑后的内容，
+// 优先使用用户自己的副本，用户删除了自己的副本或者没有这条消息时使用发送者的副本
+func (*messageReplyService) RefreshReplyTo(userId int64, messages []*pb.Message) error {
+	var messageIds []int64
+	for _, message := range messages {
+		if message.ReplyTo != nil && len(message.ReplyTo.MessageContent) > 0 {
+			messageIds = append(messageIds, message.ReplyTo.MessageId)
+		}
+	}
+	if len(messageIds) == 0 {
+		return nil
+	}
+
+	originals, err := repo.MessageRepo.ListByMessageIds(userId, messageIds)
+	if err != nil {
+		return err
+	}
+	originalMap := make(map[int64]*model.Message, len(originals))
+	for i := range originals {
+		originalMap[originals[i].MessageId] = &originals[i]
+	}
+
+	for _, message := range messages {
+		replyTo := message.ReplyTo
+		if replyTo == nil || len(replyTo.MessageContent) == 0 {
+			continue
+		}
+		original, ok := originalMap[replyTo.MessageId]
+		if !ok && replyTo.Sender != nil && replyTo.Sender.SenderType == pb.SenderType_ST_USER {
+			original, err = repo.MessageRepo.GetByMessageId(replyTo.Sender.SenderId, replyTo.MessageId)
+			if err != nil {
+				return err
+			}
+		}
+		if original == nil {
+			continue
+		}
+		if !original.HasContent() {
+			replyTo.MessageContent = nil
+		} else if original.EditTime != nil {
+			replyTo.MessageContent = original.Content
+		}
+	}
+	return nil
+}
+
+// GetThread 获取用户消息列表中群组话题的根消息和回复列表
+func (*messageReplyService) GetThread(ctx context.Context, userId int64, req *pb.GetThreadReq) (*pb.GetThreadResp, error) {
+	root, err := repo.MessageRepo.GetByMessageId(userId, req.RootMessageId)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil || root.ReceiverType != int32(pb.ReceiverType_RT_GROUP) || root.ReceiverId != req.GroupId {
+		return nil, gerrors.ErrMessageNotFound
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 || limit > MessageLimit {
+		limit = MessageLimit
+	}
+	replies, hasMore, err := repo.MessageRepo.ListThread(userId, req.RootMessageId, req.BeforeSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pbMessages := model.MessagesToPB(append([]model.Message{*root}, replies...))
+	err = MessageService.AddMessagesSenderInfo(ctx, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReplyService.RefreshReplyTo(userId, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReactionService.AddMessagesReactions(pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetThreadResp{Root: pbMessages[0], Replies: pbMessages[1:], HasMore: hasMore}, nil
+}
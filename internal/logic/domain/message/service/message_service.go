@@ -2,14 +2,20 @@ package service
 
 import (
 	"context"
+	"gim/config"
+	"gim/internal/logic/domain/conversation"
 	"gim/internal/logic/domain/message/model"
 	"gim/internal/logic/domain/message/repo"
+	"gim/internal/logic/domain/message/search"
 	"gim/internal/logic/proxy"
+	"gim/pkg/gerrors"
 	"gim/pkg/grpclib"
 	"gim/pkg/logger"
 	"gim/pkg/pb"
 	"gim/pkg/rpc"
 	"gim/pkg/util"
+	"sort"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -49,32 +55,181 @@ func (*messageService) Sync(ctx context.Context, userId, seq int64) (*pb.SyncRes
 		}
 	}
 
-	var userIds = make(map[int64]int32, len(resp.Messages))
-	for i := range resp.Messages {
-		if resp.Messages[i].Sender.SenderType == pb.SenderType_ST_USER {
-			userIds[resp.Messages[i].Sender.SenderId] = 0
+	// 添加resp消息的一些状态信息
+	err = MessageService.AddMessagesSenderInfo(ctx, resp.Messages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReplyService.RefreshReplyTo(userId, resp.Messages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReactionService.AddMessagesReactions(resp.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	// 将同步的消息都返回
+	return resp, nil
+}
+
+// AddMessagesSenderInfo 批量补充消息发送者的用户信息
+func (*messageService) AddMessagesSenderInfo(ctx context.Context, messages []*pb.Message) error {
+	var userIds = make(map[int64]int32, len(messages))
+	for i := range messages {
+		if messages[i].Sender.SenderType == pb.SenderType_ST_USER {
+			userIds[messages[i].Sender.SenderId] = 0
 		}
 	}
-	// 添加resp消息的一些状态信息
+	if len(userIds) == 0 {
+		return nil
+	}
+
 	usersResp, err := rpc.BusinessIntClient.GetUsers(ctx, &pb.GetUsersReq{UserIds: userIds})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	for i := range resp.Messages {
-		if resp.Messages[i].Sender.SenderType == pb.SenderType_ST_USER {
-			user, ok := usersResp.Users[resp.Messages[i].Sender.SenderId]
+	for i := range messages {
+		if messages[i].Sender.SenderType == pb.SenderType_ST_USER {
+			user, ok := usersResp.Users[messages[i].Sender.SenderId]
 			if ok {
-				resp.Messages[i].Sender.Nickname = user.Nickname
-				resp.Messages[i].Sender.AvatarUrl = user.AvatarUrl
-				resp.Messages[i].Sender.Extra = user.Extra
+				messages[i].Sender.Nickname = user.Nickname
+				messages[i].Sender.AvatarUrl = user.AvatarUrl
+				messages[i].Sender.Extra = user.Extra
 			} else {
-				logger.Logger.Warn("get user failed", zap.Int64("user_id", resp.Messages[i].Sender.SenderId))
+				logger.Logger.Warn("get user failed", zap.Int64("user_id", messages[i].Sender.SenderId))
 			}
 		}
 	}
+	return nil
+}
+
+// GetHistory 向前分页获取用户在会话中的历史消息
+func (*messageService) GetHistory(ctx context.Context, userId int64, req *pb.GetHistoryReq) (*pb.GetHistoryResp, error) {
+	limit := int64(req.Limit)
+	if limit <= 0 || limit > MessageLimit {
+		limit = MessageLimit
+	}
 
-	// 将同步的消息都返回
-	return resp, nil
+	messages, hasMore, err := repo.MessageRepo.ListHistory(userId, int32(req.ReceiverType), req.ReceiverId, req.BeforeSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	pbMessages := model.MessagesToPB(messages)
+	err = MessageService.AddMessagesSenderInfo(ctx, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReplyService.RefreshReplyTo(userId, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReactionService.AddMessagesReactions(pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetHistoryResp{Messages: pbMessages, HasMore: hasMore}, nil
+}
+
+// GetMentions 向前分页获取用户消息列表中@用户的消息
+func (*messageService) GetMentions(ctx context.Context, userId int64, req *pb.GetMentionsReq) (*pb.GetMentionsResp, error) {
+	limit := int64(req.Limit)
+	if limit <= 0 || limit > MessageLimit {
+		limit = MessageLimit
+	}
+
+	messages, hasMore, err := repo.MessageRepo.ListMentions(userId, req.GroupId, req.BeforeSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	pbMessages := model.MessagesToPB(messages)
+	err = MessageService.AddMessagesSenderInfo(ctx, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReplyService.RefreshReplyTo(userId, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReactionService.AddMessagesReactions(pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMentionsResp{Messages: pbMessages, HasMore: hasMore}, nil
+}
+
+// SearchMessages 搜索用户的文本消息
+func (*messageService) SearchMessages(ctx context.Context, userId int64, req *pb.SearchMessagesReq) ([]*pb.Message, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > MessageLimit {
+		limit = MessageLimit
+	}
+
+	docs, err := repo.MessageIndex.Search(&search.Query{
+		UserId:       userId,
+		Keyword:      req.Keyword,
+		ReceiverType: int32(req.ReceiverType),
+		ReceiverId:   req.ReceiverId,
+		SenderId:     req.SenderId,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	seqs := make([]int64, len(docs))
+	for i := range docs {
+		seqs[i] = docs[i].Seq
+	}
+	messages, err := repo.MessageRepo.ListBySeqs(userId, seqs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 以DB中的消息为准，过滤掉索引更新不及时的已撤回消息
+	result := make([]model.Message, 0, len(messages))
+	for i := range messages {
+		if messages[i].Status == int32(pb.MessageStatus_MS_NORMAL) {
+			result = append(result, messages[i])
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Seq > result[j].Seq
+	})
+
+	pbMessages := model.MessagesToPB(result)
+	err = MessageService.AddMessagesSenderInfo(ctx, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReplyService.RefreshReplyTo(userId, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	return pbMessages, nil
+}
+
+// ListBySeqs 根据序列号批量获取用户的消息，并补充发送者信息
+func (*messageService) ListBySeqs(ctx context.Context, userId int64, seqs []int64) ([]*pb.Message, error) {
+	messages, err := repo.MessageRepo.ListBySeqs(userId, seqs)
+	if err != nil {
+		return nil, err
+	}
+	pbMessages := model.MessagesToPB(messages)
+	err = MessageService.AddMessagesSenderInfo(ctx, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	err = MessageReplyService.RefreshReplyTo(userId, pbMessages)
+	if err != nil {
+		return nil, err
+	}
+	return pbMessages, nil
 }
 
 // ListByUserIdAndSeq 查询消息
@@ -89,10 +244,20 @@ func (*messageService) ListByUserIdAndSeq(ctx context.Context, userId, seq int64
 	return repo.MessageRepo.ListBySeq(userId, seq, MessageLimit)
 }
 
-// SendToUser 将消息发送给用户
-func (*messageService) SendToUser(ctx context.Context, sender *pb.Sender, toUserId int64, req *pb.SendMessageReq) (int64, error) {
+// NewMessageId 生成全局唯一的消息id
+func (*messageService) NewMessageId() (int64, error) {
+	messageId, err := util.MessageIdUid.Get()
+	if err != nil {
+		return 0, gerrors.WrapError(err)
+	}
+	return messageId, nil
+}
+
+// SendToUser 将消息发送给用户，messageId为消息id，同一条消息写扩散到多个用户时共用一个messageId
+func (*messageService) SendToUser(ctx context.Context, messageId int64, sender *pb.Sender, toUserId int64, req *pb.SendMessageReq) (int64, error) {
 	logger.Logger.Debug("SendToUser",
 		zap.Int64("request_id", grpclib.GetCtxRequestId(ctx)),
+		zap.Int64("message_id", messageId),
 		zap.Int64("to_user_id", toUserId))
 	var (
 		seq int64 = 0
@@ -108,19 +273,9 @@ func (*messageService) SendToUser(ctx context.Context, sender *pb.Sender, toUser
 		}
 
 		//  创建message
-		selfMessage := model.Message{
-			UserId:       toUserId,
-			RequestId:    grpclib.GetCtxRequestId(ctx),
-			SenderType:   int32(sender.SenderType),
-			SenderId:     sender.SenderId,
-			ReceiverType: int32(req.ReceiverType),
-			ReceiverId:   req.ReceiverId,
-			ToUserIds:    model.FormatUserIds(req.ToUserIds),
-			Type:         int(req.MessageType),
-			Content:      req.MessageContent,
-			Seq:          seq,
-			SendTime:     util.UnunixMilliTime(req.SendTime),
-			Status:       int32(pb.MessageStatus_MS_NORMAL),
+		selfMessage, err := newMessage(ctx, messageId, sender, toUserId, seq, req)
+		if err != nil {
+			return 0, err
 		}
 		// 消息存到DB中
 		err = repo.MessageRepo.Save(selfMessage)
@@ -129,6 +284,14 @@ func (*messageService) SendToUser(ctx context.Context, sender *pb.Sender, toUser
 			return 0, err
 		}
 
+		// 更新用户消息列表中话题根消息的回复数，失败不影响消息发送
+		if selfMessage.ThreadRootId != 0 {
+			err = repo.MessageRepo.IncrReplyCount(toUserId, selfMessage.ThreadRootId, selfMessage.SendTime)
+			if err != nil {
+				logger.Logger.Error("incr reply count error", zap.Int64("user_id", toUserId), zap.Error(err))
+			}
+		}
+
 		if sender.SenderType == pb.SenderType_ST_USER && sender.SenderId == toUserId {
 			// 用户需要增加自己的已经同步的序列号
 			err = repo.DeviceACKRepo.Set(sender.SenderId, sender.DeviceId, seq)
@@ -136,20 +299,12 @@ func (*messageService) SendToUser(ctx context.Context, sender *pb.Sender, toUser
 				return 0, err
 			}
 		}
+
+		MessageService.updateConversation(ctx, toUserId, &selfMessage)
 	}
 
 	// 创建PB消息
-	message := pb.Message{
-		Sender:         sender,
-		ReceiverType:   req.ReceiverType,
-		ReceiverId:     req.ReceiverId,
-		ToUserIds:      req.ToUserIds,
-		MessageType:    req.MessageType,
-		MessageContent: req.MessageContent,
-		Seq:            seq,
-		SendTime:       req.SendTime,
-		Status:         pb.MessageStatus_MS_NORMAL,
-	}
+	message := newPBMessage(messageId, sender, toUserId, seq, req)
 
 	// 查询用户在线设备
 	devices, err := proxy.DeviceProxy.ListOnlineByUserId(ctx, toUserId)
@@ -165,7 +320,7 @@ func (*messageService) SendToUser(ctx context.Context, sender *pb.Sender, toUser
 		}
 
 		// 向设备发送消息
-		err = MessageService.SendToDevice(ctx, devices[i], &message)
+		err = MessageService.SendToDevice(ctx, devices[i], message)
 		if err != nil {
 			logger.Sugar.Error(err, zap.Any("SendToUser error", devices[i]), zap.Error(err))
 		}
@@ -174,6 +329,124 @@ func (*messageService) SendToUser(ctx context.Context, sender *pb.Sender, toUser
 	return seq, nil
 }
 
+// newMessage 创建接收者消息列表中的一条消息
+func newMessage(ctx context.Context, messageId int64, sender *pb.Sender, toUserId, seq int64, req *pb.SendMessageReq) (model.Message, error) {
+	message := model.Message{
+		UserId:       toUserId,
+		RequestId:    grpclib.GetCtxRequestId(ctx),
+		SenderType:   int32(sender.SenderType),
+		SenderId:     sender.SenderId,
+		ReceiverType: int32(req.ReceiverType),
+		ReceiverId:   req.ReceiverId,
+		ToUserIds:    model.FormatUserIds(req.ToUserIds),
+		Type:         int(req.MessageType),
+		Content:      req.MessageContent,
+		Seq:          seq,
+		MessageId:    messageId,
+		SendTime:     util.UnunixMilliTime(req.SendTime),
+		Status:       int32(pb.MessageStatus_MS_NORMAL),
+		Extra:        req.Extra,
+		IsMentionAll: req.IsMentionAll,
+		IsMentioned:  isMentioned(sender, toUserId, req),
+		CreateTime:   time.Now(),
+	}
+	if req.Ttl > 0 {
+		expireTime := message.CreateTime.Add(time.Duration(req.Ttl) * time.Second)
+		message.Ttl = req.Ttl
+		message.ExpireTime = &expireTime
+	}
+	if req.ReplyTo != nil {
+		replyTo, err := proto.Marshal(req.ReplyTo)
+		if err != nil {
+			return message, gerrors.WrapError(err)
+		}
+		message.ReplyTo = replyTo
+		message.ThreadRootId = req.ReplyTo.ThreadRootId
+	}
+	return message, nil
+}
+
+// newPBMessage 创建投递给接收者设备的消息
+func newPBMessage(messageId int64, sender *pb.Sender, toUserId, seq int64, req *pb.SendMessageReq) *pb.Message {
+	message := &pb.Message{
+		Sender:         sender,
+		ReceiverType:   req.ReceiverType,
+		ReceiverId:     req.ReceiverId,
+		ToUserIds:      req.ToUserIds,
+		MessageType:    req.MessageType,
+		MessageContent: req.MessageContent,
+		Seq:            seq,
+		SendTime:       req.SendTime,
+		Status:         pb.MessageStatus_MS_NORMAL,
+		MessageId:      messageId,
+		ReplyTo:        req.ReplyTo,
+		Ttl:            req.Ttl,
+		Extra:          req.Extra,
+		IsMentionAll:   req.IsMentionAll,
+		IsMentioned:    isMentioned(sender, toUserId, req),
+	}
+	if req.Ttl > 0 {
+		message.ExpireTime = util.UnixMilliTime(time.Now().Add(time.Duration(req.Ttl) * time.Second))
+	}
+	return message
+}
+
+// isMentioned 接收者是否被@，发送者自己的副本不算被@
+func isMentioned(sender *pb.Sender, toUserId int64, req *pb.SendMessageReq) bool {
+	if req.ReceiverType != pb.ReceiverType_RT_GROUP {
+		return false
+	}
+	if sender.SenderType == pb.SenderType_ST_USER && sender.SenderId == toUserId {
+		return false
+	}
+	if req.IsMentionAll {
+		return true
+	}
+	for _, userId := range req.ToUserIds {
+		if userId == toUserId {
+			return true
+		}
+	}
+	return false
+}
+
+// lastMessage 消息对应的会话的最后一条消息，指令消息和单聊中的系统消息不会出现在会话中
+func lastMessage(userId int64, message *model.Message) (conversation.LastMessage, bool) {
+	if message.Type == int(pb.MessageType_MT_COMMAND) {
+		return conversation.LastMessage{}, false
+	}
+	// 单聊只有用户之间的消息会出现在会话中
+	if message.ReceiverType == int32(pb.ReceiverType_RT_USER) && message.SenderType != int32(pb.SenderType_ST_USER) {
+		return conversation.LastMessage{}, false
+	}
+
+	receiverType, receiverId := message.ConversationOf(userId)
+	return conversation.LastMessage{
+		UserId:       userId,
+		ReceiverType: receiverType,
+		ReceiverId:   receiverId,
+		Seq:          message.Seq,
+		MessageId:    message.MessageId,
+		SendTime:     message.SendTime,
+		IsUnread:     !message.IsSentBy(userId),
+		IsMentioned:  message.IsMentioned,
+	}, true
+}
+
+// updateConversation 更新用户会话的最后一条消息，会话更新失败不影响消息发送
+func (*messageService) updateConversation(ctx context.Context, userId int64, message *model.Message) {
+	last, ok := lastMessage(userId, message)
+	if !ok {
+		return
+	}
+
+	err := conversation.ConversationService.UpdateLastMessage(ctx, userId, last.ReceiverType, last.ReceiverId,
+		last.Seq, last.MessageId, last.SendTime, last.IsUnread, last.IsMentioned)
+	if err != nil {
+		logger.Logger.Error("update conversation error", zap.Int64("user_id", userId), zap.Error(err))
+	}
+}
+
 // SendToDevice 将消息发送给设备
 func (*messageService) SendToDevice(ctx context.Context, device *pb.Device, message *pb.Message) error {
 	messageSend := pb.MessageSend{Message: message}
@@ -191,6 +464,53 @@ func (*messageService) SendToDevice(ctx context.Context, device *pb.Device, mess
 	return nil
 }
 
+// GetRecallMessage 获取用户要撤回的消息，并检查用户是否有权限撤回
+func (*messageService) GetRecallMessage(ctx context.Context, userId, seq int64) (*model.Message, error) {
+	message, err := repo.MessageRepo.Get(userId, seq)
+	if err != nil {
+		return nil, err
+	}
+	if message == nil {
+		return nil, gerrors.ErrMessageNotFound
+	}
+
+	err = message.CheckRecall(userId, config.Logic.MessageRecallDuration)
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// ListUserIds 获取所有持有这条消息副本的用户
+func (*messageService) ListUserIds(ctx context.Context, message *model.Message) ([]int64, error) {
+	return repo.MessageRepo.ListUserIds(message)
+}
+
+// Recall 撤回消息，将所有持有这条消息的用户消息列表中的这条消息标记为撤回，并推送撤回指令，
+// 部分用户标记失败时返回错误，不推送撤回指令，客户端可以重新撤回
+func (*messageService) Recall(ctx context.Context, message *model.Message, userIds []int64) error {
+	err := repo.MessageRepo.UpdateStatusBatch(userIds, message, int32(pb.MessageStatus_MS_RECALL))
+	if err != nil {
+		return err
+	}
+
+	push := &pb.RecallMessagePush{
+		OptId:        message.SenderId,
+		ReceiverType: pb.ReceiverType(message.ReceiverType),
+		ReceiverId:   message.ReceiverId,
+		SendTime:     util.UnixMilliTime(message.SendTime),
+		RecallTime:   util.UnixMilliTime(time.Now()),
+		MessageId:    message.MessageId,
+	}
+	for _, userId := range userIds {
+		err := PushService.PushToUser(ctx, userId, pb.PushCode_PC_RECALL_MESSAGE, push, true)
+		if err != nil {
+			logger.Logger.Error("push recall message error", zap.Int64("user_id", userId), zap.Error(err))
+		}
+	}
+	return nil
+}
+
 func (*messageService) AddSenderInfo(sender *pb.Sender) {
 	if sender.SenderType == pb.SenderType_ST_USER {
 		user, err := rpc.BusinessIntClient.GetUser(context.TODO(), &pb.GetUserReq{UserId: sender.SenderId})
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gim/config"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/logger"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrShardMigrating    = errors.New("消息表正在重新分表")
+	ErrShardNotMigrating = errors.New("消息表没有在重新分表或者不在当前阶段")
+	ErrShardNotCopied    = errors.New("存量消息还没有复制完成，请先执行copy")
+)
+
+const shardReloadTimeout = 10 // 等待所有实例加载分表配置的最大加载间隔数
+
+type messageShardService struct{}
+
+// MessageShardService 消息表重新分表，流程为：Start创建新分表并开启双写，Copy复制存量消息，Cutover切换到新分表并补齐复制之后新分表写入失败的消息，
+// 每一步都需要确认所有实例已经加载到对应的状态
+var MessageShardService = new(messageShardService)
+
+// NextLayout 重新分表的目标分表，没有在重新分表时版本为当前版本加一
+func (*messageShardService) NextLayout(tableNum int) (model.MessageLayout, error) {
+	shard, err := repo.MessageShardRepo.GetFromDB()
+	if err != nil {
+		return model.MessageLayout{}, err
+	}
+	if shard.Status != model.ShardStatusNormal {
+		return shard.Next(), nil
+	}
+	return model.MessageLayout{Version: shard.Version + 1, TableNum: tableNum}, nil
+}
+
+// DDL 生成分表的建表语句，使用当前分表的第一张表作为模板
+func (*messageShardService) DDL(ctx context.Context, layout model.MessageLayout) (string, error) {
+	shard, err := repo.MessageShardRepo.GetFromDB()
+	if err != nil {
+		return "", err
+	}
+	template := shard.Current().TableName(0)
+	ddl, err := repo.MessageShardRepo.ShowCreateTable(template)
+	if err != nil {
+		return "", err
+	}
+	return model.BuildDDL(ddl, template, layout), nil
+}
+
+// Start 创建新分表并开启双写，等待所有实例加载到双写状态后返回，之后才可以复制存量消息
+func (s *messageShardService) Start(ctx context.Context, tableNum int) error {
+	shard, err := repo.MessageShardRepo.GetFromDB()
+	if err != nil {
+		return err
+	}
+	if shard.Status != model.ShardStatusNormal {
+		return ErrShardMigrating
+	}
+
+	next := model.MessageLayout{Version: shard.Version + 1, TableNum: tableNum}
+	template := shard.Current().TableName(0)
+	for _, table := range next.TableNames() {
+		err = repo.MessageShardRepo.CreateTableLike(table, template)
+		if err != nil {
+			return err
+		}
+	}
+
+	shard.NextVersion = next.Version
+	shard.NextTableNum = next.TableNum
+	err = s.save(shard, model.ShardStatusDualWrite)
+	if err != nil {
+		return err
+	}
+	return s.waitReload(shard.State())
+}
+
+// Copy 将当前分表中的存量消息复制到新分表，每次复制batchSize个主键范围内的消息，可以重复执行，
+// 复制之前确认所有实例都已经开启双写，全部复制完成后记录为已复制，并记录每张表复制到的最大主键
+func (s *messageShardService) Copy(ctx context.Context, batchSize int64) (int64, error) {
+	shard, err := repo.MessageShardRepo.GetFromDB()
+	if err != nil {
+		return 0, err
+	}
+	if shard.Status != model.ShardStatusDualWrite && shard.Status != model.ShardStatusCopied {
+		return 0, ErrShardNotMigrating
+	}
+	// 有实例没有开启双写时，复制之后这个实例写入的消息不会出现在新分表中
+	err = s.waitReload(shard.StateOf(model.ShardStatusDualWrite), shard.StateOf(model.ShardStatusCopied))
+	if err != nil {
+		return 0, err
+	}
+
+	// 开启双写之后插入的消息已经写入新分表，只需要复制当前最大主键之前的消息
+	var total int64
+	tables := shard.Current().TableNames()
+	maxIds := make([]int64, len(tables))
+	for i, table := range tables {
+		maxIds[i], err = repo.MessageShardRepo.MaxId(table)
+		if err != nil {
+			return total, err
+		}
+		count, err := copyTable(table, shard.Next(), 0, maxIds[i], batchSize)
+		total += count
+		if err != nil {
+			return total, err
+		}
+	}
+	shard.SetCopiedMaxIds(maxIds)
+	return total, s.save(shard, model.ShardStatusCopied)
+}
+
+// copyTable 将当前分表中一张表主键在(minId, maxId]之间的消息分批复制到新分表，返回复制的消息数量
+func copyTable(table string, next model.MessageLayout, minId, maxId, batchSize int64) (int64, error) {
+	var total int64
+	for ; minId < maxId; minId += batchSize {
+		count, err := repo.MessageShardRepo.Copy(table, next, minId, minId+batchSize)
+		if err != nil {
+			return total, err
+		}
+		total += count
+		logger.Logger.Info("copy messages", zap.String("table", table), zap.Int64("min_id", minId),
+			zap.Int64("max_id", maxId), zap.Int64("count", count))
+	}
+	return total, nil
+}
+
+// Cutover 存量消息复制完成后，先切换到从新分表读取，等待所有实例加载后，新分表写入失败的消息会直接返回错误，
+// 再从复制时记录的最大主键开始补齐复制，之后停止写入旧分表，旧分表需要手动删除
+func (s *messageShardService) Cutover(ctx context.Context, batchSize int64) error {
+	shard, err := repo.MessageShardRepo.GetFromDB()
+	if err != nil {
+		return err
+	}
+	if shard.Status == model.ShardStatusDualWrite {
+		return ErrShardNotCopied
+	}
+
+	if shard.Status == model.ShardStatusCopied {
+		err = s.save(shard, model.ShardStatusSwitched)
+		if err != nil {
+			return err
+		}
+	}
+	if shard.Status != model.ShardStatusSwitched {
+		return ErrShardNotMigrating
+	}
+	// 所有实例都从新分表读取之后才能停止写入旧分表
+	err = s.waitReload(shard.State())
+	if err != nil {
+		return err
+	}
+
+	// 复制之后双写新分表失败的消息只写入了旧分表，补齐之后才能删除旧分表
+	maxIds := shard.GetCopiedMaxIds()
+	for i, table := range shard.Current().TableNames() {
+		maxId, err := repo.MessageShardRepo.MaxId(table)
+		if err != nil {
+			return err
+		}
+		_, err = copyTable(table, shard.Next(), maxIds[i], maxId, batchSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	shard.Version = shard.NextVersion
+	shard.TableNum = shard.NextTableNum
+	shard.NextVersion = 0
+	shard.NextTableNum = 0
+	shard.CopiedMaxIds = ""
+	return s.save(shard, model.ShardStatusNormal)
+}
+
+func (*messageShardService) save(shard *model.MessageShard, status int) error {
+	now := time.Now()
+	if shard.CreateTime.IsZero() {
+		shard.CreateTime = now
+	}
+	shard.UpdateTime = now
+	shard.Status = status
+	return repo.MessageShardRepo.Save(shard)
+}
+
+// waitReload 等待所有实例上报已经加载到states中的一个状态，超时后返回没有加载的实例
+func (*messageShardService) waitReload(states ...string) error {
+	interval := config.Logic.MessageShardReloadInterval
+	var lagging []string
+	for i := 0; i < shardReloadTimeout; i++ {
+		acks, err := repo.MessageShardRepo.ListAcks()
+		if err != nil {
+			return err
+		}
+		lagging = laggingInstances(acks, states)
+		if len(lagging) == 0 {
+			return nil
+		}
+		time.Sleep(interval)
+	}
+	return fmt.Errorf("以下实例没有加载最新的分表配置，请检查后重新执行：%s", strings.Join(lagging, ","))
+}
+
+// laggingInstances 没有加载到states中任何一个状态的实例
+func laggingInstances(acks map[string]string, states []string) []string {
+	var lagging []string
+	for instance, state := range acks {
+		ok := false
+		for _, s := range states {
+			if state == s {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			lagging = append(lagging, instance+"("+state+")")
+		}
+	}
+	sort.Strings(lagging)
+	return lagging
+}
@@ -38,8 +38,13 @@ func (s *pushService) PushToUser(ctx context.Context, userId int64, code pb.Push
 		return gerrors.WrapError(err)
 	}
 
+	messageId, err := MessageService.NewMessageId()
+	if err != nil {
+		return err
+	}
+
 	// 发送控制信息
-	_, err = MessageService.SendToUser(ctx,
+	_, err = MessageService.SendToUser(ctx, messageId,
 		&pb.Sender{
 			SenderType: pb.SenderType_ST_SYSTEM,
 			SenderId:   0,
@@ -13,3 +13,8 @@ var SeqService = new(seqService)
 func (*seqService) GetUserNext(ctx context.Context, userId int64) (int64, error) {
 	return repo.SeqRepo.Incr(repo.SeqObjectTypeUser, userId)
 }
+
+// GetUsersNext 批量获取多个用户的下一个序列号
+func (*seqService) GetUsersNext(ctx context.Context, userIds []int64) (map[int64]int64, error) {
+	return repo.SeqRepo.IncrBatch(repo.SeqObjectTypeUser, userIds)
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"gim/config"
+	"gim/internal/logic/domain/message/repo"
+	"gim/internal/logic/proxy"
+	"gim/pkg/gerrors"
+	"gim/pkg/grpclib"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/rpc"
+	"gim/pkg/util"
+
+	"go.uber.org/zap"
+)
+
+type signalService struct{}
+
+var SignalService = new(signalService)
+
+// CheckRate 检查用户发送瞬时信号的频率
+func (*signalService) CheckRate(ctx context.Context, userId int64) error {
+	count, err := repo.SignalRateRepo.Incr(userId)
+	if err != nil {
+		return err
+	}
+	if count > config.Logic.SignalRateLimit {
+		return gerrors.ErrTooFrequent
+	}
+	return nil
+}
+
+// Send 将瞬时信号异步投递给用户的在线设备，不持久化，发送者自己的设备不投递，不等待投递完成
+func (s *signalService) Send(ctx context.Context, userId, deviceId int64, userIds []int64, signal *pb.SignalInput) {
+	output := &pb.SignalOutput{
+		SenderId:     userId,
+		ReceiverType: signal.ReceiverType,
+		ReceiverId:   signal.ReceiverId,
+		SignalType:   signal.SignalType,
+		Data:         signal.Data,
+	}
+	sendCtx := grpclib.NewAndCopyRequestId(ctx)
+	go func() {
+		defer util.RecoverPanic()
+		s.deliver(sendCtx, userId, deviceId, userIds, output)
+	}()
+}
+
+// deliver 将瞬时信号投递给用户的在线设备
+func (*signalService) deliver(ctx context.Context, userId, deviceId int64, userIds []int64, output *pb.SignalOutput) {
+	for _, toUserId := range userIds {
+		if toUserId == userId {
+			continue
+		}
+		devices, err := proxy.DeviceProxy.ListOnlineByUserId(ctx, toUserId)
+		if err != nil {
+			logger.Logger.Error("list online devices error", zap.Int64("user_id", toUserId), zap.Error(err))
+			continue
+		}
+		for i := range devices {
+			if devices[i].DeviceId == deviceId {
+				continue
+			}
+			_, err = rpc.ConnectIntClient.DeliverSignal(grpclib.ContextWithAddr(ctx, devices[i].ConnAddr), &pb.DeliverSignalReq{
+				DeviceId: devices[i].DeviceId,
+				Signal:   output,
+			})
+			if err != nil {
+				logger.Logger.Error("deliver signal error", zap.Int64("device_id", devices[i].DeviceId), zap.Error(err))
+			}
+		}
+	}
+}
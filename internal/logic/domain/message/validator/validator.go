@@ -0,0 +1,209 @@
+package validator
+
+import (
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+	"math"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	MaxTextLen     = 5000       // 文本消息的最大字符数
+	MaxURLLen      = 2048       // URL的最大长度
+	MaxNameLen     = 255        // 文件名、地理位置描述的最大字符数
+	MaxCustomLen   = 64 * 1024  // 自定义消息的最大字节数
+	MaxVoiceSecond = 10 * 60    // 语音的最大时长，单位秒
+	MaxFileSize    = 1024 << 20 // 文件的最大字节数
+	MaxExtraLen    = 1024       // 消息附加字段的最大字节数，和message表的extra字段长度一致
+)
+
+// URLSchemes 允许的URL协议
+var URLSchemes = map[string]int{
+	"http":  0,
+	"https": 0,
+}
+
+// CustomValidator 自定义消息的校验函数，返回error表示消息内容不合法
+type CustomValidator func(custom *pb.Custom) error
+
+var customValidators []CustomValidator
+
+// RegisterCustom 注册自定义消息的校验函数，发送自定义消息时按注册顺序依次校验，需要在服务启动之前注册
+func RegisterCustom(validator CustomValidator) {
+	customValidators = append(customValidators, validator)
+}
+
+// serverOnlyTypes 只能由服务端生成的消息类型，指令消息由服务端推送，合并转发消息由转发接口根据原消息生成
+var serverOnlyTypes = map[pb.MessageType]bool{
+	pb.MessageType_MT_COMMAND: true,
+	pb.MessageType_MT_MERGE:   true,
+}
+
+// ValidateUserType 校验用户是否可以直接发送这个类型的消息
+func ValidateUserType(messageType pb.MessageType) error {
+	if serverOnlyTypes[messageType] {
+		return gerrors.ErrBadRequest
+	}
+	return nil
+}
+
+// ValidateExtra 校验消息附加字段的长度
+func ValidateExtra(extra string) error {
+	if len(extra) > MaxExtraLen {
+		return gerrors.ErrBadMessageContent
+	}
+	return nil
+}
+
+// Validate 按照消息类型解析消息内容，并且校验内容是否合法
+func Validate(messageType pb.MessageType, content []byte) error {
+	switch messageType {
+	case pb.MessageType_MT_TEXT:
+		var text pb.Text
+		if err := proto.Unmarshal(content, &text); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return validateText(&text)
+	case pb.MessageType_MT_FACE:
+		var face pb.Face
+		if err := proto.Unmarshal(content, &face); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return validateFace(&face)
+	case pb.MessageType_MT_VOICE:
+		var voice pb.Voice
+		if err := proto.Unmarshal(content, &voice); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return validateVoice(&voice)
+	case pb.MessageType_MT_IMAGE:
+		var image pb.Image
+		if err := proto.Unmarshal(content, &image); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return validateImage(&image)
+	case pb.MessageType_MT_FILE:
+		var file pb.File
+		if err := proto.Unmarshal(content, &file); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return validateFile(&file)
+	case pb.MessageType_MT_LOCATION:
+		var location pb.Location
+		if err := proto.Unmarshal(content, &location); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return validateLocation(&location)
+	case pb.MessageType_MT_COMMAND:
+		var command pb.Command
+		if err := proto.Unmarshal(content, &command); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return nil
+	case pb.MessageType_MT_CUSTOM:
+		var custom pb.Custom
+		if err := proto.Unmarshal(content, &custom); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return validateCustom(&custom)
+	case pb.MessageType_MT_MERGE:
+		var merge pb.Merge
+		if err := proto.Unmarshal(content, &merge); err != nil {
+			return gerrors.ErrBadMessageContent
+		}
+		return nil
+	default:
+		return gerrors.ErrBadMessageContent
+	}
+}
+
+func validateText(text *pb.Text) error {
+	if strings.TrimSpace(text.Text) == "" || utf8.RuneCountInString(text.Text) > MaxTextLen {
+		return gerrors.ErrBadMessageContent
+	}
+	return nil
+}
+
+func validateFace(face *pb.Face) error {
+	if face.FaceId <= 0 && face.FaceUrl == "" {
+		return gerrors.ErrBadMessageContent
+	}
+	return validateURL(face.FaceUrl, false)
+}
+
+func validateVoice(voice *pb.Voice) error {
+	if voice.Size < 0 || voice.Duration <= 0 || voice.Duration > MaxVoiceSecond {
+		return gerrors.ErrBadMessageContent
+	}
+	return validateURL(voice.Url, true)
+}
+
+func validateImage(image *pb.Image) error {
+	if image.Width < 0 || image.Height < 0 {
+		return gerrors.ErrBadMessageContent
+	}
+	if err := validateURL(image.Url, true); err != nil {
+		return err
+	}
+	return validateURL(image.ThumbnailUrl, false)
+}
+
+func validateFile(file *pb.File) error {
+	if file.Name == "" || utf8.RuneCountInString(file.Name) > MaxNameLen {
+		return gerrors.ErrBadMessageContent
+	}
+	if file.Size < 0 || file.Size > MaxFileSize {
+		return gerrors.ErrBadMessageContent
+	}
+	return validateURL(file.Url, true)
+}
+
+func validateLocation(location *pb.Location) error {
+	if utf8.RuneCountInString(location.Desc) > MaxNameLen {
+		return gerrors.ErrBadMessageContent
+	}
+	if math.IsNaN(location.Latitude) || location.Latitude < -90 || location.Latitude > 90 {
+		return gerrors.ErrBadMessageContent
+	}
+	if math.IsNaN(location.Longitude) || location.Longitude < -180 || location.Longitude > 180 {
+		return gerrors.ErrBadMessageContent
+	}
+	return nil
+}
+
+func validateCustom(custom *pb.Custom) error {
+	if len(custom.Data) > MaxCustomLen {
+		return gerrors.ErrBadMessageContent
+	}
+	for _, validator := range customValidators {
+		if err := validator(custom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateURL 校验URL的长度和协议，required为false时允许为空
+func validateURL(rawURL string, required bool) error {
+	if rawURL == "" {
+		if required {
+			return gerrors.ErrBadMessageContent
+		}
+		return nil
+	}
+	if len(rawURL) > MaxURLLen {
+		return gerrors.ErrBadMessageContent
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return gerrors.ErrBadMessageContent
+	}
+	if _, ok := URLSchemes[strings.ToLower(u.Scheme)]; !ok {
+		return gerrors.ErrBadMessageContent
+	}
+	return nil
+}
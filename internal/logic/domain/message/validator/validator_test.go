@@ -0,0 +1,100 @@
+package validator
+
+import (
+	"errors"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func marshal(t *testing.T, message proto.Message) []byte {
+	bytes, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		messageType pb.MessageType
+		message     proto.Message
+		ok          bool
+	}{
+		{"text", pb.MessageType_MT_TEXT, &pb.Text{Text: "hello"}, true},
+		{"empty text", pb.MessageType_MT_TEXT, &pb.Text{Text: "  "}, false},
+		{"long text", pb.MessageType_MT_TEXT, &pb.Text{Text: strings.Repeat("长", MaxTextLen+1)}, false},
+		{"face id", pb.MessageType_MT_FACE, &pb.Face{FaceId: 1}, true},
+		{"empty face", pb.MessageType_MT_FACE, &pb.Face{}, false},
+		{"image", pb.MessageType_MT_IMAGE, &pb.Image{Url: "https://a.com/1.png", Width: 1, Height: 1}, true},
+		{"image no url", pb.MessageType_MT_IMAGE, &pb.Image{}, false},
+		{"image bad scheme", pb.MessageType_MT_IMAGE, &pb.Image{Url: "javascript://a.com/alert(1)"}, false},
+		{"image bad thumbnail", pb.MessageType_MT_IMAGE, &pb.Image{Url: "https://a.com/1.png", ThumbnailUrl: "file:///etc/passwd"}, false},
+		{"voice", pb.MessageType_MT_VOICE, &pb.Voice{Url: "http://a.com/1.amr", Duration: 3}, true},
+		{"voice no duration", pb.MessageType_MT_VOICE, &pb.Voice{Url: "http://a.com/1.amr"}, false},
+		{"file", pb.MessageType_MT_FILE, &pb.File{Name: "a.txt", Size: 1, Url: "https://a.com/a.txt"}, true},
+		{"file no name", pb.MessageType_MT_FILE, &pb.File{Url: "https://a.com/a.txt"}, false},
+		{"location", pb.MessageType_MT_LOCATION, &pb.Location{Latitude: 39.9, Longitude: 116.4}, true},
+		{"bad latitude", pb.MessageType_MT_LOCATION, &pb.Location{Latitude: 91}, false},
+		{"bad longitude", pb.MessageType_MT_LOCATION, &pb.Location{Longitude: -181}, false},
+		{"custom", pb.MessageType_MT_CUSTOM, &pb.Custom{Data: "{}"}, true},
+		{"unknown", pb.MessageType_MT_UNKNOWN, &pb.Text{Text: "hello"}, false},
+	}
+	for _, tt := range tests {
+		err := Validate(tt.messageType, marshal(t, tt.message))
+		if tt.ok && err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		if !tt.ok && err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+
+	err := Validate(pb.MessageType_MT_TEXT, []byte{0xff})
+	if err != gerrors.ErrBadMessageContent {
+		t.Errorf("bad bytes: %v", err)
+	}
+}
+
+func TestRegisterCustom(t *testing.T) {
+	defer func() { customValidators = nil }()
+
+	errBadCustom := errors.New("bad custom")
+	RegisterCustom(func(custom *pb.Custom) error {
+		if custom.Data == "bad" {
+			return errBadCustom
+		}
+		return nil
+	})
+
+	if err := Validate(pb.MessageType_MT_CUSTOM, marshal(t, &pb.Custom{Data: "good"})); err != nil {
+		t.Fatal(err)
+	}
+	if err := Validate(pb.MessageType_MT_CUSTOM, marshal(t, &pb.Custom{Data: "bad"})); err != errBadCustom {
+		t.Fatalf("expected errBadCustom, got %v", err)
+	}
+}
+
+func TestValidateExtra(t *testing.T) {
+	if err := ValidateExtra(strings.Repeat("a", MaxExtraLen)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateExtra(strings.Repeat("a", MaxExtraLen+1)); err != gerrors.ErrBadMessageContent {
+		t.Fatalf("expected ErrBadMessageContent, got %v", err)
+	}
+}
+
+func TestValidateUserType(t *testing.T) {
+	if err := ValidateUserType(pb.MessageType_MT_TEXT); err != nil {
+		t.Fatal(err)
+	}
+	for _, messageType := range []pb.MessageType{pb.MessageType_MT_COMMAND, pb.MessageType_MT_MERGE} {
+		if err := ValidateUserType(messageType); err != gerrors.ErrBadRequest {
+			t.Fatalf("%v: expected ErrBadRequest, got %v", messageType, err)
+		}
+	}
+}
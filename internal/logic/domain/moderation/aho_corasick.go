@@ -0,0 +1,117 @@
+package moderation
+
+import (
+	"unicode"
+)
+
+// Matcher Aho-Corasick自动机，用于在文本中一次查找多个敏感词，匹配不区分大小写
+type Matcher struct {
+	nodes []acNode
+}
+
+type acNode struct {
+	next  map[rune]int // 子节点
+	fail  int          // 失败指针
+	depth int          // 节点深度，等于字符数
+	match int          // 以该节点结尾的最长敏感词的长度，0表示不是敏感词结尾
+}
+
+// NewMatcher 使用敏感词列表构建自动机
+func NewMatcher(words []string) *Matcher {
+	m := &Matcher{nodes: []acNode{{next: map[rune]int{}}}}
+	for _, word := range words {
+		m.add(word)
+	}
+	m.build()
+	return m
+}
+
+func (m *Matcher) add(word string) {
+	cur := 0
+	for _, r := range word {
+		r = unicode.ToLower(r)
+		next, ok := m.nodes[cur].next[r]
+		if !ok {
+			next = len(m.nodes)
+			m.nodes = append(m.nodes, acNode{next: map[rune]int{}, depth: m.nodes[cur].depth + 1})
+			m.nodes[cur].next[r] = next
+		}
+		cur = next
+	}
+	if cur != 0 {
+		m.nodes[cur].match = m.nodes[cur].depth
+	}
+}
+
+// build 按层次遍历计算失败指针
+func (m *Matcher) build() {
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			fail := m.nodes[cur].fail
+			for fail != 0 {
+				if _, ok := m.nodes[fail].next[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].next[r]; ok && next != child {
+				m.nodes[child].fail = next
+			}
+			// 后缀也是敏感词时，记录最长的那个
+			if m.nodes[m.nodes[child].fail].match > m.nodes[child].match {
+				m.nodes[child].match = m.nodes[m.nodes[child].fail].match
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Contains 文本中是否包含敏感词
+func (m *Matcher) Contains(text string) bool {
+	cur := 0
+	for _, r := range text {
+		cur = m.step(cur, unicode.ToLower(r))
+		if m.nodes[cur].match > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Replace 将文本中的敏感词替换为mask，返回替换后的文本和是否有替换
+func (m *Matcher) Replace(text string, mask rune) (string, bool) {
+	runes := []rune(text)
+	replaced := false
+	cur := 0
+	for i, r := range runes {
+		cur = m.step(cur, unicode.ToLower(r))
+		if length := m.nodes[cur].match; length > 0 {
+			for j := i - length + 1; j <= i; j++ {
+				runes[j] = mask
+			}
+			replaced = true
+		}
+	}
+	if !replaced {
+		return text, false
+	}
+	return string(runes), true
+}
+
+func (m *Matcher) step(cur int, r rune) int {
+	for {
+		if next, ok := m.nodes[cur].next[r]; ok {
+			return next
+		}
+		if cur == 0 {
+			return 0
+		}
+		cur = m.nodes[cur].fail
+	}
+}
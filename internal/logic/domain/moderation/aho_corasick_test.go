@@ -0,0 +1,36 @@
+package moderation
+
+import "testing"
+
+func TestMatcher_Replace(t *testing.T) {
+	m := NewMatcher([]string{"he", "she", "his", "hers", "坏人", "坏", ""})
+
+	tests := []struct {
+		text     string
+		expect   string
+		replaced bool
+	}{
+		{"ushers", "u*****", true},
+		{"HIS car", "*** car", true},
+		{"abc", "abc", false},
+		{"你是坏人吗", "你是**吗", true},
+		{"坏蛋", "*蛋", true},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, replaced := m.Replace(tt.text, '*')
+		if got != tt.expect || replaced != tt.replaced {
+			t.Errorf("Replace(%q) = %q, %v; want %q, %v", tt.text, got, replaced, tt.expect, tt.replaced)
+		}
+		if m.Contains(tt.text) != tt.replaced {
+			t.Errorf("Contains(%q) = %v", tt.text, !tt.replaced)
+		}
+	}
+}
+
+func TestMatcher_Empty(t *testing.T) {
+	m := NewMatcher(nil)
+	if m.Contains("anything") {
+		t.Fatal("empty matcher should not match")
+	}
+}
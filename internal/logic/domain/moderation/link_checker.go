@@ -0,0 +1,113 @@
+package moderation
+
+import (
+	"context"
+	"gim/config"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var linkRegexp = regexp.MustCompile(`(?i)(?:https?://|www\.)[\w\-.~:/?#\[\]@!$&()*+,;=%]+`)
+
+type linkChecker struct{}
+
+// LinkChecker 内置的链接检查器，拒绝发送包含可疑链接的消息，
+// 文本和自定义消息检查内容中的链接，图片和文件消息检查资源的URL
+var LinkChecker = new(linkChecker)
+
+// Moderate 检查消息中的链接
+func (c *linkChecker) Moderate(ctx context.Context, sender *pb.Sender, messageType pb.MessageType, content []byte) (*Result, error) {
+	var links, fileURLs []string
+	switch messageType {
+	case pb.MessageType_MT_TEXT:
+		var text pb.Text
+		if err := proto.Unmarshal(content, &text); err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		links = FindLinks(text.Text)
+	case pb.MessageType_MT_CUSTOM:
+		var custom pb.Custom
+		if err := proto.Unmarshal(content, &custom); err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		links = FindLinks(custom.Data)
+	case pb.MessageType_MT_IMAGE:
+		var image pb.Image
+		if err := proto.Unmarshal(content, &image); err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		fileURLs = []string{image.Url, image.ThumbnailUrl}
+	case pb.MessageType_MT_FILE:
+		var file pb.File
+		if err := proto.Unmarshal(content, &file); err != nil {
+			return nil, gerrors.WrapError(err)
+		}
+		fileURLs = []string{file.Url}
+	default:
+		return Allow, nil
+	}
+
+	for _, link := range links {
+		if IsSuspiciousLink(link) {
+			return &Result{Action: ActionReject, Reason: "消息包含可疑链接"}, nil
+		}
+	}
+	for _, fileURL := range fileURLs {
+		if fileURL == "" {
+			continue
+		}
+		if IsSuspiciousLink(fileURL) || !isFileURLAllowed(fileURL) {
+			return &Result{Action: ActionReject, Reason: "资源地址不合法"}, nil
+		}
+	}
+	return Allow, nil
+}
+
+// FindLinks 找出文本中的所有链接
+func FindLinks(text string) []string {
+	return linkRegexp.FindAllString(text, -1)
+}
+
+// IsSuspiciousLink 链接是否可疑，无法解析、带有用户信息（例如http://good.com@evil.com）或者域名被禁止的链接是可疑的
+func IsSuspiciousLink(link string) bool {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	u, err := url.Parse(link)
+	if err != nil || u.Hostname() == "" {
+		return true
+	}
+	if u.User != nil {
+		return true
+	}
+	return matchHost(u.Hostname(), config.Logic.BlockedLinkHosts)
+}
+
+// isFileURLAllowed 资源URL的域名是否在允许的域名中，没有配置时不限制
+func isFileURLAllowed(fileURL string) bool {
+	if len(config.Logic.FileURLHosts) == 0 {
+		return true
+	}
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return false
+	}
+	return matchHost(u.Hostname(), config.Logic.FileURLHosts)
+}
+
+// matchHost host是否是hosts中的域名或者其子域名
+func matchHost(host string, hosts []string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	for _, h := range hosts {
+		h = strings.ToLower(h)
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
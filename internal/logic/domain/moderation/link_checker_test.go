@@ -0,0 +1,73 @@
+package moderation
+
+import (
+	"context"
+	"gim/config"
+	"gim/pkg/pb"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFindLinks(t *testing.T) {
+	links := FindLinks("看看 https://example.com/a?b=1 和 www.test.com，还有HTTP://Upper.com")
+	if len(links) != 3 || links[0] != "https://example.com/a?b=1" || links[1] != "www.test.com" || links[2] != "HTTP://Upper.com" {
+		t.Fatalf("links = %v", links)
+	}
+}
+
+func TestIsSuspiciousLink(t *testing.T) {
+	old := config.Logic.BlockedLinkHosts
+	defer func() { config.Logic.BlockedLinkHosts = old }()
+	config.Logic.BlockedLinkHosts = []string{"evil.com"}
+
+	tests := []struct {
+		link string
+		want bool
+	}{
+		{"https://example.com/a", false},
+		{"www.example.com", false},
+		{"https://evil.com/a", true},
+		{"https://a.EVIL.com", true},
+		{"https://notevil.com", false},
+		{"https://example.com@evil.org", true},
+		{"https://", true},
+	}
+	for _, tt := range tests {
+		if got := IsSuspiciousLink(tt.link); got != tt.want {
+			t.Errorf("IsSuspiciousLink(%s) = %v, want %v", tt.link, got, tt.want)
+		}
+	}
+}
+
+func TestLinkChecker_Moderate(t *testing.T) {
+	old := config.Logic
+	defer func() { config.Logic = old }()
+	config.Logic.BlockedLinkHosts = []string{"evil.com"}
+	config.Logic.FileURLHosts = []string{"cdn.gim.com"}
+
+	tests := []struct {
+		messageType pb.MessageType
+		message     proto.Message
+		want        Action
+	}{
+		{pb.MessageType_MT_TEXT, &pb.Text{Text: "hello https://example.com"}, ActionAllow},
+		{pb.MessageType_MT_TEXT, &pb.Text{Text: "点击 http://evil.com/win 领奖"}, ActionReject},
+		{pb.MessageType_MT_CUSTOM, &pb.Custom{Data: `{"url":"https://www.evil.com"}`}, ActionReject},
+		{pb.MessageType_MT_IMAGE, &pb.Image{Url: "https://cdn.gim.com/1.png", ThumbnailUrl: "https://cdn.gim.com/1_s.png"}, ActionAllow},
+		{pb.MessageType_MT_IMAGE, &pb.Image{Url: "https://other.com/1.png"}, ActionReject},
+		{pb.MessageType_MT_FILE, &pb.File{Url: "https://img.cdn.gim.com/a.zip"}, ActionAllow},
+		{pb.MessageType_MT_FILE, &pb.File{Url: "https://evil.com/a.exe"}, ActionReject},
+		{pb.MessageType_MT_LOCATION, &pb.Location{Desc: "https://evil.com"}, ActionAllow},
+	}
+	for _, tt := range tests {
+		content, _ := proto.Marshal(tt.message)
+		result, err := LinkChecker.Moderate(context.TODO(), &pb.Sender{}, tt.messageType, content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Action != tt.want {
+			t.Errorf("%v %v: action = %v, want %v", tt.messageType, tt.message, result.Action, tt.want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package moderation
+
+import (
+	"context"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+)
+
+// Action 审核结果的处理方式
+type Action int
+
+const (
+	ActionAllow   Action = 0 // 放行
+	ActionReject  Action = 1 // 拒绝发送
+	ActionRewrite Action = 2 // 改写消息内容后放行，例如屏蔽敏感词
+)
+
+// Result 审核结果
+type Result struct {
+	Action  Action // 处理方式
+	Reason  string // 拒绝原因，返回给发送者
+	Content []byte // 改写后的消息内容，Action为ActionRewrite时有效
+}
+
+// Allow 放行的审核结果
+var Allow = &Result{Action: ActionAllow}
+
+// Moderator 消息内容审核器，返回error表示审核过程出错，消息不会发送
+type Moderator interface {
+	Moderate(ctx context.Context, sender *pb.Sender, messageType pb.MessageType, content []byte) (*Result, error)
+}
+
+var moderators = []Moderator{SensitiveWordFilter, LinkChecker}
+
+// Register 注册自定义审核器，在内置审核器之后按注册顺序执行，需要在服务启动之前注册
+func Register(moderator Moderator) {
+	moderators = append(moderators, moderator)
+}
+
+// Moderate 依次执行审核器，前一个审核器改写的内容交给后一个审核器审核，返回最终的消息内容
+func Moderate(ctx context.Context, sender *pb.Sender, messageType pb.MessageType, content []byte) ([]byte, error) {
+	for _, moderator := range moderators {
+		result, err := moderator.Moderate(ctx, sender, messageType, content)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			continue
+		}
+
+		switch result.Action {
+		case ActionReject:
+			return nil, gerrors.NewContentRejected(result.Reason)
+		case ActionRewrite:
+			content = result.Content
+		}
+	}
+	return content, nil
+}
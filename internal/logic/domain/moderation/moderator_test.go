@@ -0,0 +1,49 @@
+package moderation
+
+import (
+	"context"
+	"gim/pkg/gerrors"
+	"gim/pkg/pb"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type funcModerator func(content []byte) *Result
+
+func (f funcModerator) Moderate(ctx context.Context, sender *pb.Sender, messageType pb.MessageType, content []byte) (*Result, error) {
+	return f(content), nil
+}
+
+func TestModerate(t *testing.T) {
+	old := moderators
+	defer func() { moderators = old }()
+
+	SensitiveWordFilter.matcher.Store(NewMatcher([]string{"坏人"}))
+	moderators = []Moderator{SensitiveWordFilter}
+	Register(funcModerator(func(content []byte) *Result {
+		var text pb.Text
+		_ = proto.Unmarshal(content, &text)
+		if text.Text == "reject" {
+			return &Result{Action: ActionReject, Reason: "不允许"}
+		}
+		return Allow
+	}))
+
+	content, _ := proto.Marshal(&pb.Text{Text: "你是坏人"})
+	got, err := Moderate(context.TODO(), &pb.Sender{}, pb.MessageType_MT_TEXT, content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var text pb.Text
+	_ = proto.Unmarshal(got, &text)
+	if text.Text != "你是**" {
+		t.Fatalf("got %q", text.Text)
+	}
+
+	content, _ = proto.Marshal(&pb.Text{Text: "reject"})
+	_, err = Moderate(context.TODO(), &pb.Sender{}, pb.MessageType_MT_TEXT, content)
+	if err == nil || err.Error() != gerrors.NewContentRejected("不允许").Error() {
+		t.Fatalf("expected rejected, got %v", err)
+	}
+}
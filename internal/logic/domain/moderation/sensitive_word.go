@@ -0,0 +1,11 @@
+package moderation
+
+import "time"
+
+// SensitiveWord 敏感词
+type SensitiveWord struct {
+	Id         int64     // 自增主键
+	Word       string    // 敏感词
+	CreateTime time.Time // 创建时间
+	UpdateTime time.Time // 更新时间
+}
@@ -0,0 +1,92 @@
+package moderation
+
+import (
+	"context"
+	"gim/config"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const SensitiveWordMask = '*'
+
+type sensitiveWordFilter struct {
+	matcher atomic.Value // *Matcher
+}
+
+// SensitiveWordFilter 内置的敏感词过滤器，敏感词来自配置和数据库，文本消息中的敏感词会被屏蔽，
+// 如果配置了SensitiveWordReject，包含敏感词的消息会被拒绝发送
+var SensitiveWordFilter = new(sensitiveWordFilter)
+
+// Load 重新加载敏感词，构建好新的自动机之后再替换，不影响正在进行的审核
+func (f *sensitiveWordFilter) Load() error {
+	words, err := SensitiveWordRepo.ListWords()
+	if err != nil {
+		return err
+	}
+	words = append(words, config.Logic.SensitiveWords...)
+	f.matcher.Store(NewMatcher(words))
+	return nil
+}
+
+// Start 加载敏感词，并且定时重新加载，修改数据库中的敏感词之后不需要重启服务，
+// 先使用配置中的敏感词，从数据库加载成功之后再合并数据库中的敏感词
+func (f *sensitiveWordFilter) Start() {
+	f.matcher.Store(NewMatcher(config.Logic.SensitiveWords))
+	err := f.Load()
+	if err != nil {
+		logger.Logger.Error("load sensitive words error", zap.Error(err))
+	}
+
+	go func() {
+		ticker := time.NewTicker(config.Logic.SensitiveWordReloadInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			func() {
+				defer util.RecoverPanic()
+				err := f.Load()
+				if err != nil {
+					logger.Logger.Error("reload sensitive words error", zap.Error(err))
+				}
+			}()
+		}
+	}()
+}
+
+// Moderate 审核文本消息
+func (f *sensitiveWordFilter) Moderate(ctx context.Context, sender *pb.Sender, messageType pb.MessageType, content []byte) (*Result, error) {
+	matcher, ok := f.matcher.Load().(*Matcher)
+	if !ok || messageType != pb.MessageType_MT_TEXT {
+		return Allow, nil
+	}
+
+	var text pb.Text
+	err := proto.Unmarshal(content, &text)
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+
+	if config.Logic.SensitiveWordReject {
+		if matcher.Contains(text.Text) {
+			return &Result{Action: ActionReject, Reason: "消息包含敏感词"}, nil
+		}
+		return Allow, nil
+	}
+
+	masked, replaced := matcher.Replace(text.Text, SensitiveWordMask)
+	if !replaced {
+		return Allow, nil
+	}
+	text.Text = masked
+	bytes, err := proto.Marshal(&text)
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return &Result{Action: ActionRewrite, Content: bytes}, nil
+}
@@ -0,0 +1,20 @@
+package moderation
+
+import (
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+)
+
+type sensitiveWordRepo struct{}
+
+var SensitiveWordRepo = new(sensitiveWordRepo)
+
+// ListWords 获取所有敏感词
+func (*sensitiveWordRepo) ListWords() ([]string, error) {
+	var words []string
+	err := db.DB.Model(&SensitiveWord{}).Pluck("word", &words).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return words, nil
+}
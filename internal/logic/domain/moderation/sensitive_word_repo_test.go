@@ -0,0 +1,29 @@
+package moderation
+
+import (
+	"fmt"
+	"gim/pkg/db"
+	"sync"
+	"testing"
+)
+
+var (
+	dbOnce sync.Once
+	dbErr  interface{}
+)
+
+// initDB 初始化数据库，数据库不可用时跳过测试，不影响同一个包中不依赖数据库的测试
+func initDB(t *testing.T) {
+	dbOnce.Do(func() {
+		defer func() { dbErr = recover() }()
+		db.InitByTest()
+	})
+	if dbErr != nil {
+		t.Skip("db not available:", dbErr)
+	}
+}
+
+func TestSensitiveWordRepo_ListWords(t *testing.T) {
+	initDB(t)
+	fmt.Println(SensitiveWordRepo.ListWords())
+}
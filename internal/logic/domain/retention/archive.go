@@ -0,0 +1,117 @@
+package retention
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"gim/internal/logic/domain/message/model"
+	"gim/pkg/gerrors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// archiveRecord 归档文件中的一行，一条消息和它的编辑历史，同一条消息的多个副本只有第一个副本带有编辑历史
+type archiveRecord struct {
+	model.Message
+	Edits []model.MessageEdit `json:",omitempty"`
+}
+
+// ArchiveWriter 归档文件，gzip压缩的JSONL格式，每行一条消息
+type ArchiveWriter struct {
+	Path    string
+	file    *os.File
+	gzip    *gzip.Writer
+	encoder *json.Encoder
+}
+
+// NewArchiveWriter 在dir目录下创建归档文件，文件名为消息表名加上创建时间
+func NewArchiveWriter(dir, table string, now time.Time) (*ArchiveWriter, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%s_%s.jsonl.gz", table, now.Format("20060102150405")))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	gzipWriter := gzip.NewWriter(file)
+	return &ArchiveWriter{
+		Path:    path,
+		file:    file,
+		gzip:    gzipWriter,
+		encoder: json.NewEncoder(gzipWriter),
+	}, nil
+}
+
+// Write 写入一批消息和它们的编辑历史，edits的key为消息id，返回时消息已经落盘，调用方可以放心地从DB中删除
+func (w *ArchiveWriter) Write(messages []model.Message, edits map[int64][]model.MessageEdit) error {
+	written := make(map[int64]bool, len(edits))
+	for i := range messages {
+		record := archiveRecord{Message: messages[i]}
+		messageId := messages[i].MessageId
+		if messageId != 0 && !written[messageId] {
+			record.Edits = edits[messageId]
+			written[messageId] = true
+		}
+		err := w.encoder.Encode(&record)
+		if err != nil {
+			return gerrors.WrapError(err)
+		}
+	}
+	err := w.gzip.Flush()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	err = w.file.Sync()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// Close 关闭归档文件
+func (w *ArchiveWriter) Close() error {
+	err := w.gzip.Close()
+	if err != nil {
+		w.file.Close()
+		return gerrors.WrapError(err)
+	}
+	err = w.file.Close()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// ReadArchive 按顺序读取归档文件中的消息和它们的编辑历史
+func ReadArchive(path string, fn func(message *model.Message, edits []model.MessageEdit) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	defer gzipReader.Close()
+
+	decoder := json.NewDecoder(bufio.NewReader(gzipReader))
+	for decoder.More() {
+		var record archiveRecord
+		err = decoder.Decode(&record)
+		if err != nil {
+			return gerrors.WrapError(err)
+		}
+		err = fn(&record.Message, record.Edits)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package retention
+
+import (
+	"bytes"
+	"gim/internal/logic/domain/message/model"
+	"testing"
+	"time"
+)
+
+func TestArchive(t *testing.T) {
+	dir := t.TempDir()
+	writer, err := NewArchiveWriter(dir, "message_000", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := []model.Message{
+		{Id: 1, UserId: 1, Seq: 1, MessageId: 10, Content: []byte{1, 2, 3}, SendTime: time.Now()},
+		{Id: 2, UserId: 2, Seq: 1, MessageId: 10, Content: []byte("hello")},
+	}
+	edits := map[int64][]model.MessageEdit{10: {{Id: 1, MessageId: 10, Content: []byte("old")}}}
+	err = writer.Write(messages[:1], edits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.Write(messages[1:], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result []model.Message
+	var resultEdits [][]model.MessageEdit
+	err = ReadArchive(writer.Path, func(message *model.Message, edits []model.MessageEdit) error {
+		result = append(result, *message)
+		resultEdits = append(resultEdits, edits)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != len(messages) {
+		t.Fatalf("len(result) = %d", len(result))
+	}
+	for i := range messages {
+		if result[i].Id != messages[i].Id || result[i].UserId != messages[i].UserId || !bytes.Equal(result[i].Content, messages[i].Content) {
+			t.Fatalf("message %d = %+v", i, result[i])
+		}
+	}
+	if !result[0].SendTime.Equal(messages[0].SendTime) {
+		t.Fatalf("send time = %v", result[0].SendTime)
+	}
+	if len(resultEdits[0]) != 1 || string(resultEdits[0][0].Content) != "old" || len(resultEdits[1]) != 0 {
+		t.Fatalf("edits = %+v", resultEdits)
+	}
+}
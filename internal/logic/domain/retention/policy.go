@@ -0,0 +1,41 @@
+package retention
+
+import (
+	"gim/config"
+	"time"
+)
+
+// Rule 一次清理的条件，由保留策略展开得到
+type Rule struct {
+	ReceiverType int32     // 接收者类型
+	MessageType  int32     // 消息类型，为0时表示除了ExcludeTypes之外的所有消息类型
+	ExcludeTypes []int32   // 单独配置了策略的消息类型，由对应的策略处理
+	Before       time.Time // 清理发送时间早于Before的消息
+	Archive      bool      // 清理前是否归档
+}
+
+// BuildRules 根据保留策略生成清理条件，同一接收者类型下，单独配置的消息类型策略优先于通用策略
+func BuildRules(policies []config.RetentionPolicy, now time.Time) []Rule {
+	rules := make([]Rule, 0, len(policies))
+	for _, policy := range policies {
+		if policy.Duration <= 0 {
+			continue
+		}
+
+		rule := Rule{
+			ReceiverType: policy.ReceiverType,
+			MessageType:  policy.MessageType,
+			Before:       now.Add(-policy.Duration),
+			Archive:      policy.Archive,
+		}
+		if policy.MessageType == 0 {
+			for _, other := range policies {
+				if other.ReceiverType == policy.ReceiverType && other.MessageType != 0 {
+					rule.ExcludeTypes = append(rule.ExcludeTypes, other.MessageType)
+				}
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
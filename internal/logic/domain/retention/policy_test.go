@@ -0,0 +1,36 @@
+package retention
+
+import (
+	"gim/config"
+	"gim/pkg/pb"
+	"testing"
+	"time"
+)
+
+func TestBuildRules(t *testing.T) {
+	now := time.Now()
+	policies := []config.RetentionPolicy{
+		{ReceiverType: int32(pb.ReceiverType_RT_GROUP), Duration: 30 * 24 * time.Hour},
+		{ReceiverType: int32(pb.ReceiverType_RT_GROUP), MessageType: int32(pb.MessageType_MT_IMAGE), Duration: 7 * 24 * time.Hour, Archive: true},
+		{ReceiverType: int32(pb.ReceiverType_RT_USER), Duration: 0},
+		{ReceiverType: int32(pb.ReceiverType_RT_USER), MessageType: int32(pb.MessageType_MT_TEXT), Duration: time.Hour},
+	}
+
+	rules := BuildRules(policies, now)
+	if len(rules) != 3 {
+		t.Fatalf("len(rules) = %d", len(rules))
+	}
+
+	if len(rules[0].ExcludeTypes) != 1 || rules[0].ExcludeTypes[0] != int32(pb.MessageType_MT_IMAGE) {
+		t.Fatalf("exclude types = %v", rules[0].ExcludeTypes)
+	}
+	if !rules[0].Before.Equal(now.Add(-30 * 24 * time.Hour)) {
+		t.Fatalf("before = %v", rules[0].Before)
+	}
+	if rules[1].ExcludeTypes != nil || !rules[1].Archive {
+		t.Fatalf("rule = %+v", rules[1])
+	}
+	if rules[2].ReceiverType != int32(pb.ReceiverType_RT_USER) || rules[2].MessageType != int32(pb.MessageType_MT_TEXT) {
+		t.Fatalf("rule = %+v", rules[2])
+	}
+}
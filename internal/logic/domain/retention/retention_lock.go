@@ -0,0 +1,63 @@
+package retention
+
+import (
+	"fmt"
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const RetentionLockKey = "retention_lock:%s"
+
+// 只有锁的持有者才能续期和释放锁
+var (
+	extendScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("pexpire", KEYS[1], ARGV[2]) else return 0 end`)
+	unlockScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) else return 0 end`)
+)
+
+// tableLock 清理一张消息表的分布式锁，多个实例同时执行保留策略时，一张表同时只有一个实例清理
+type tableLock struct {
+	key        string
+	value      string
+	expiration time.Duration
+}
+
+func newTableLock(table string, expiration time.Duration) *tableLock {
+	hostname, _ := os.Hostname()
+	return &tableLock{
+		key:        fmt.Sprintf(RetentionLockKey, table),
+		value:      hostname + ":" + strconv.Itoa(os.Getpid()) + ":" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		expiration: expiration,
+	}
+}
+
+// Lock 获取锁，锁已经被其他实例持有时返回false
+func (l *tableLock) Lock() (bool, error) {
+	ok, err := db.RedisCli.SetNX(l.key, l.value, l.expiration).Result()
+	if err != nil {
+		return false, gerrors.WrapError(err)
+	}
+	return ok, nil
+}
+
+// Extend 续期锁，锁已经过期并且被其他实例获取时返回false
+func (l *tableLock) Extend() (bool, error) {
+	result, err := extendScript.Run(db.RedisCli, []string{l.key}, l.value, l.expiration.Milliseconds()).Int64()
+	if err != nil {
+		return false, gerrors.WrapError(err)
+	}
+	return result == 1, nil
+}
+
+// Unlock 释放锁
+func (l *tableLock) Unlock() error {
+	err := unlockScript.Run(db.RedisCli, []string{l.key}, l.value).Err()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
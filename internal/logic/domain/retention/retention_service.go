@@ -0,0 +1,188 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"gim/config"
+	"gim/internal/logic/domain/message/model"
+	"gim/internal/logic/domain/message/repo"
+	"gim/pkg/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// LockExpiration 清理消息表的锁的有效期，每清理一批消息续期一次
+const LockExpiration = 10 * time.Minute
+
+var ErrLockLost = errors.New("清理消息表的锁已经失效")
+
+type retentionService struct{}
+
+var RetentionService = new(retentionService)
+
+// Run 按照保留策略清理所有消息表中过期的消息，需要归档的消息先写入归档文件再删除，
+// 每张表加分布式锁，已经有其他实例在清理的表跳过
+func (s *retentionService) Run(ctx context.Context) error {
+	now := time.Now()
+	rules := BuildRules(config.Logic.RetentionPolicies, now)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	for _, table := range repo.MessageRepo.TableNames() {
+		err := s.lockAndPurgeTable(table, rules, now)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *retentionService) lockAndPurgeTable(table string, rules []Rule, now time.Time) error {
+	lock := newTableLock(table, LockExpiration)
+	ok, err := lock.Lock()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		logger.Logger.Info("message table is purging by other instance", zap.String("table", table))
+		return nil
+	}
+	defer func() {
+		err := lock.Unlock()
+		if err != nil {
+			logger.Logger.Error("unlock retention error", zap.String("table", table), zap.Error(err))
+		}
+	}()
+
+	return s.purgeTable(lock, table, rules, now)
+}
+
+// purgeTable 清理一张消息表，一张表一次执行只生成一个归档文件
+func (*retentionService) purgeTable(lock *tableLock, table string, rules []Rule, now time.Time) error {
+	var writer *ArchiveWriter
+	defer func() {
+		if writer == nil {
+			return
+		}
+		err := writer.Close()
+		if err != nil {
+			logger.Logger.Error("close archive error", zap.String("path", writer.Path), zap.Error(err))
+		}
+	}()
+
+	batchSize := config.Logic.RetentionBatchSize
+	for _, rule := range rules {
+		for {
+			ok, err := lock.Extend()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return ErrLockLost
+			}
+
+			messages, err := repo.MessageRepo.ListBefore(table, rule.ReceiverType, rule.MessageType, rule.ExcludeTypes, rule.Before, batchSize)
+			if err != nil {
+				return err
+			}
+			if len(messages) == 0 {
+				break
+			}
+
+			if rule.Archive {
+				if writer == nil {
+					writer, err = NewArchiveWriter(config.Logic.RetentionArchiveDir, table, now)
+					if err != nil {
+						return err
+					}
+				}
+				err = archive(writer, messages)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = repo.MessageRepo.Purge(messages)
+			if err != nil {
+				return err
+			}
+			logger.Logger.Info("purge messages", zap.String("table", table), zap.Int32("receiver_type", rule.ReceiverType),
+				zap.Int32("message_type", rule.MessageType), zap.Bool("archive", rule.Archive), zap.Int("count", len(messages)))
+
+			if int64(len(messages)) < batchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// archive 将一批消息和它们的编辑历史写入归档文件
+func archive(writer *ArchiveWriter, messages []model.Message) error {
+	messageIds := make([]int64, 0, len(messages))
+	for i := range messages {
+		if messages[i].MessageId != 0 {
+			messageIds = append(messageIds, messages[i].MessageId)
+		}
+	}
+	edits, err := repo.MessageEditRepo.ListByMessageIds(messageIds)
+	if err != nil {
+		return err
+	}
+	return writer.Write(messages, edits)
+}
+
+// Restore 从归档文件中恢复消息和编辑历史，userId为0时恢复文件中的所有消息，返回恢复的消息数量，
+// 恢复之后通知所有实例重新加载这些用户的消息索引
+func (*retentionService) Restore(ctx context.Context, path string, userId int64) (int, error) {
+	var count int
+	userIds := make(map[int64]struct{})
+	err := ReadArchive(path, func(message *model.Message, edits []model.MessageEdit) error {
+		if userId != 0 && message.UserId != userId {
+			return nil
+		}
+		ok, err := repo.MessageRepo.Restore(*message)
+		if err != nil {
+			return err
+		}
+		err = restoreEdits(message.MessageId, edits)
+		if err != nil {
+			return err
+		}
+		if ok {
+			count++
+			userIds[message.UserId] = struct{}{}
+		}
+		return nil
+	})
+
+	// 恢复失败时，已经恢复的消息同样需要重新加载索引
+	for id := range userIds {
+		resetErr := repo.ResetMessageIndex(id)
+		if resetErr != nil {
+			logger.Logger.Error("reset message index error", zap.Int64("user_id", id), zap.Error(resetErr))
+		}
+	}
+	return count, err
+}
+
+// restoreEdits 恢复消息的编辑历史，编辑历史已经存在时不恢复，主键重新生成
+func restoreEdits(messageId int64, edits []model.MessageEdit) error {
+	if len(edits) == 0 {
+		return nil
+	}
+	exists, err := repo.MessageEditRepo.Exists(messageId)
+	if err != nil || exists {
+		return err
+	}
+	for i := range edits {
+		edits[i].Id = 0
+		err = repo.MessageEditRepo.Save(&edits[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package schedule
+
+import (
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	StatusPending  = 0 // 等待发送
+	StatusSending  = 1 // 发送中
+	StatusSent     = 2 // 已发送
+	StatusCanceled = 3 // 已取消
+	StatusFailed   = 4 // 发送失败
+)
+
+// ScheduledMessage 定时消息
+type ScheduledMessage struct {
+	Id           int64     // 自增主键
+	SenderType   int32     // 发送者类型
+	SenderId     int64     // 发送者id
+	DeviceId     int64     // 发送者设备id
+	ReceiverType int32     // 接收者类型
+	ReceiverId   int64     // 接收者id
+	Request      []byte    // 发送消息请求，pb.SendMessageReq序列化后的字节
+	SendAt       time.Time // 定时发送时间
+	Status       int32     // 状态
+	Seq          int64     // 发送成功后发送者的消息序列号
+	MessageId    int64     // 发送成功后的消息id
+	Error        string    // 发送失败的原因
+	CreateTime   time.Time // 创建时间
+	UpdateTime   time.Time // 更新时间
+}
+
+// Sender 获取定时消息发送时使用的发送者，不包含设备id，
+// 发送时创建定时消息的设备不一定还在线，也没有显示这条消息，需要和其他设备一样收到推送
+func (m *ScheduledMessage) Sender() *pb.Sender {
+	return &pb.Sender{
+		SenderType: pb.SenderType(m.SenderType),
+		SenderId:   m.SenderId,
+	}
+}
+
+// GetRequest 获取发送消息请求
+func (m *ScheduledMessage) GetRequest() (*pb.SendMessageReq, error) {
+	var req pb.SendMessageReq
+	err := proto.Unmarshal(m.Request, &req)
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func (m *ScheduledMessage) ToProto() *pb.ScheduledMessage {
+	message := &pb.ScheduledMessage{
+		ScheduleId:   m.Id,
+		ReceiverType: pb.ReceiverType(m.ReceiverType),
+		ReceiverId:   m.ReceiverId,
+		SendAt:       util.UnixMilliTime(m.SendAt),
+		CreateTime:   util.UnixMilliTime(m.CreateTime),
+	}
+	req, err := m.GetRequest()
+	if err != nil {
+		logger.Sugar.Error(err)
+		return message
+	}
+	message.MessageType = req.MessageType
+	message.MessageContent = req.MessageContent
+	return message
+}
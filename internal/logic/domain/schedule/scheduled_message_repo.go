@@ -0,0 +1,77 @@
+package schedule
+
+import (
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+	"time"
+)
+
+type scheduledMessageRepo struct{}
+
+var ScheduledMessageRepo = new(scheduledMessageRepo)
+
+// Save 保存定时消息
+func (*scheduledMessageRepo) Save(message *ScheduledMessage) error {
+	err := db.DB.Create(message).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// ListPending 获取发送者等待发送的定时消息，按发送时间排序
+func (*scheduledMessageRepo) ListPending(senderType int32, senderId int64) ([]ScheduledMessage, error) {
+	var messages []ScheduledMessage
+	err := db.DB.Where("sender_type = ? and sender_id = ? and status = ?", senderType, senderId, StatusPending).
+		Order("send_at").Find(&messages).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return messages, nil
+}
+
+// ListDue 获取到了发送时间的定时消息，包括发送中但是长时间没有完成的消息，这些消息所在的实例可能已经退出
+func (*scheduledMessageRepo) ListDue(now, staleTime time.Time, limit int64) ([]ScheduledMessage, error) {
+	var messages []ScheduledMessage
+	err := db.DB.Where("(status = ? and send_at <= ?) or (status = ? and update_time <= ?)",
+		StatusPending, now, StatusSending, staleTime).
+		Order("send_at").Limit(limit).Find(&messages).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return messages, nil
+}
+
+// Claim 将定时消息标记为发送中，多个实例同时扫描时只有一个实例能够成功
+func (*scheduledMessageRepo) Claim(id int64, now, staleTime time.Time) (bool, error) {
+	result := db.DB.Exec("update scheduled_message set status = ?, update_time = ? "+
+		"where id = ? and (status = ? or (status = ? and update_time <= ?))",
+		StatusSending, now, id, StatusPending, StatusSending, staleTime)
+	if result.Error != nil {
+		return false, gerrors.WrapError(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Cancel 取消等待发送的定时消息，返回是否取消成功
+func (*scheduledMessageRepo) Cancel(senderType int32, senderId, id int64) (bool, error) {
+	result := db.DB.Exec("update scheduled_message set status = ? where id = ? and sender_type = ? and sender_id = ? and status = ?",
+		StatusCanceled, id, senderType, senderId, StatusPending)
+	if result.Error != nil {
+		return false, gerrors.WrapError(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Finish 保存定时消息的发送结果，clearRequest为true时清空保存的发送消息请求
+func (*scheduledMessageRepo) Finish(id int64, status int32, seq, messageId int64, errMsg string, clearRequest bool) error {
+	sql := "update scheduled_message set status = ?, seq = ?, message_id = ?, error = ?"
+	if clearRequest {
+		sql += ", request = ''"
+	}
+	err := db.DB.Exec(sql+" where id = ?", status, seq, messageId, errMsg, id).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package schedule
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestScheduledMessageRepo_Save(t *testing.T) {
+	message := &ScheduledMessage{
+		SenderType:   2,
+		SenderId:     1,
+		ReceiverType: 1,
+		ReceiverId:   2,
+		Request:      []byte{},
+		SendAt:       time.Now().Add(time.Minute),
+		CreateTime:   time.Now(),
+		UpdateTime:   time.Now(),
+	}
+	fmt.Println(ScheduledMessageRepo.Save(message))
+	fmt.Println(message.Id)
+}
+
+func TestScheduledMessageRepo_ListPending(t *testing.T) {
+	messages, err := ScheduledMessageRepo.ListPending(2, 1)
+	fmt.Printf("%+v\n %+v\n", messages, err)
+}
+
+func TestScheduledMessageRepo_ListDue(t *testing.T) {
+	now := time.Now()
+	messages, err := ScheduledMessageRepo.ListDue(now, now.Add(-time.Minute), 100)
+	fmt.Printf("%+v\n %+v\n", messages, err)
+}
+
+func TestScheduledMessageRepo_Cancel(t *testing.T) {
+	fmt.Println(ScheduledMessageRepo.Cancel(2, 1, 1))
+}
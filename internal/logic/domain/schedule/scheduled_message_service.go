@@ -0,0 +1,107 @@
+package schedule
+
+import (
+	"context"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/pb"
+	"gim/pkg/util"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	staleDuration = time.Minute // 发送中超过这个时长没有完成的定时消息会被重新发送
+	dueLimit      = 100         // 每次扫描的最大定时消息数量
+)
+
+type scheduledMessageService struct{}
+
+var ScheduledMessageService = new(scheduledMessageService)
+
+// Add 添加定时消息，返回定时消息id
+func (*scheduledMessageService) Add(ctx context.Context, sender *pb.Sender, req *pb.SendMessageReq) (int64, error) {
+	// 发送时由定时消息生成新的去重标识
+	request := proto.Clone(req).(*pb.SendMessageReq)
+	request.SendAt = 0
+	request.ClientMessageKey = ""
+	bytes, err := proto.Marshal(request)
+	if err != nil {
+		return 0, gerrors.WrapError(err)
+	}
+
+	message := &ScheduledMessage{
+		SenderType:   int32(sender.SenderType),
+		SenderId:     sender.SenderId,
+		DeviceId:     sender.DeviceId,
+		ReceiverType: int32(req.ReceiverType),
+		ReceiverId:   req.ReceiverId,
+		Request:      bytes,
+		SendAt:       util.UnunixMilliTime(req.SendAt),
+		Status:       StatusPending,
+		CreateTime:   time.Now(),
+		UpdateTime:   time.Now(),
+	}
+	err = ScheduledMessageRepo.Save(message)
+	if err != nil {
+		return 0, err
+	}
+	return message.Id, nil
+}
+
+// List 获取发送者等待发送的定时消息
+func (*scheduledMessageService) List(ctx context.Context, senderType pb.SenderType, senderId int64) ([]*pb.ScheduledMessage, error) {
+	messages, err := ScheduledMessageRepo.ListPending(int32(senderType), senderId)
+	if err != nil {
+		return nil, err
+	}
+	pbMessages := make([]*pb.ScheduledMessage, 0, len(messages))
+	for i := range messages {
+		pbMessages = append(pbMessages, messages[i].ToProto())
+	}
+	return pbMessages, nil
+}
+
+// Cancel 取消等待发送的定时消息
+func (*scheduledMessageService) Cancel(ctx context.Context, senderType pb.SenderType, senderId, scheduleId int64) error {
+	ok, err := ScheduledMessageRepo.Cancel(int32(senderType), senderId, scheduleId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return gerrors.ErrScheduleNotFound
+	}
+	return nil
+}
+
+// ListDue 获取需要发送的定时消息
+func (*scheduledMessageService) ListDue(ctx context.Context) ([]ScheduledMessage, error) {
+	now := time.Now()
+	return ScheduledMessageRepo.ListDue(now, now.Add(-staleDuration), dueLimit)
+}
+
+// Claim 抢占定时消息的发送权，返回是否抢占成功
+func (*scheduledMessageService) Claim(ctx context.Context, scheduleId int64) (bool, error) {
+	now := time.Now()
+	return ScheduledMessageRepo.Claim(scheduleId, now, now.Add(-staleDuration))
+}
+
+// Finish 保存定时消息的发送结果，req为发送时使用的请求，阅后即焚消息发送之后清空保存的请求，避免消息内容在销毁之后仍然保留
+func (*scheduledMessageService) Finish(ctx context.Context, scheduleId int64, req *pb.SendMessageReq, resp *pb.SendMessageResp, sendErr error) {
+	clearRequest := req != nil && req.Ttl > 0
+	var err error
+	if sendErr != nil {
+		errMsg := sendErr.Error()
+		if len(errMsg) > 255 {
+			errMsg = errMsg[:255]
+		}
+		err = ScheduledMessageRepo.Finish(scheduleId, StatusFailed, 0, 0, errMsg, clearRequest)
+	} else {
+		err = ScheduledMessageRepo.Finish(scheduleId, StatusSent, resp.Seq, resp.MessageId, "", clearRequest)
+	}
+	if err != nil {
+		logger.Logger.Error("finish scheduled message error", zap.Int64("schedule_id", scheduleId), zap.Error(err))
+	}
+}
@@ -0,0 +1,28 @@
+package webhook
+
+import "time"
+
+const (
+	DeliveryPending   = 0 // 等待投递，包括等待重试
+	DeliverySucceeded = 1 // 投递成功
+	DeliveryDead      = 2 // 超过最大投递次数，记录为死信
+)
+
+// Delivery 事件到一个接收地址的投递记录，同一个事件推送给多个地址时有多条记录
+type Delivery struct {
+	Id         int64     // 自增主键
+	EventId    int64     // 事件id
+	EventType  string    // 事件类型
+	Url        string    // 接收地址
+	Payload    []byte    // 事件JSON
+	Status     int32     // 投递状态
+	Attempts   int       // 已经投递的次数
+	NextTime   time.Time // 下次投递时间
+	LastError  string    // 最后一次投递失败的原因
+	CreateTime time.Time // 创建时间
+	UpdateTime time.Time // 更新时间
+}
+
+func (*Delivery) TableName() string {
+	return "webhook_delivery"
+}
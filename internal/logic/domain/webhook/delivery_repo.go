@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"gim/pkg/db"
+	"gim/pkg/gerrors"
+	"time"
+)
+
+type deliveryRepo struct{}
+
+var DeliveryRepo = new(deliveryRepo)
+
+// Save 保存投递记录
+func (*deliveryRepo) Save(delivery *Delivery) error {
+	err := db.DB.Create(delivery).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// ListDue 获取到了投递时间的记录
+func (*deliveryRepo) ListDue(now time.Time, limit int64) ([]Delivery, error) {
+	var deliveries []Delivery
+	err := db.DB.Where("status = ? and next_time <= ?", DeliveryPending, now).
+		Order("next_time").Limit(limit).Find(&deliveries).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return deliveries, nil
+}
+
+// Claim 将下次投递时间推迟到leaseTime，多个实例同时扫描时只有一个实例能够成功，
+// 实例在投递过程中退出后，记录会在leaseTime之后被重新投递
+func (*deliveryRepo) Claim(delivery *Delivery, leaseTime time.Time) (bool, error) {
+	result := db.DB.Exec("update webhook_delivery set next_time = ? where id = ? and status = ? and next_time = ?",
+		leaseTime, delivery.Id, DeliveryPending, delivery.NextTime)
+	if result.Error != nil {
+		return false, gerrors.WrapError(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Finish 保存投递结果
+func (*deliveryRepo) Finish(id int64, status int32, attempts int, nextTime time.Time, lastError string) error {
+	err := db.DB.Exec("update webhook_delivery set status = ?, attempts = ?, next_time = ?, last_error = ? where id = ?",
+		status, attempts, nextTime, lastError, id).Error
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+	return nil
+}
+
+// DeleteSucceeded 删除投递时间在before之前的投递成功的记录，每次最多删除limit条，返回删除的数量
+func (*deliveryRepo) DeleteSucceeded(before time.Time, limit int64) (int64, error) {
+	result := db.DB.Exec("delete from webhook_delivery where status = ? and next_time < ? limit ?",
+		DeliverySucceeded, before, limit)
+	if result.Error != nil {
+		return 0, gerrors.WrapError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// ListDead 获取死信记录，按id倒序
+func (*deliveryRepo) ListDead(limit int64) ([]Delivery, error) {
+	var deliveries []Delivery
+	err := db.DB.Where("status = ?", DeliveryDead).Order("id desc").Limit(limit).Find(&deliveries).Error
+	if err != nil {
+		return nil, gerrors.WrapError(err)
+	}
+	return deliveries, nil
+}
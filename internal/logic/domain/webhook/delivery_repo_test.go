@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"fmt"
+	"gim/pkg/db"
+	"sync"
+	"testing"
+	"time"
+)
+
+var (
+	dbOnce sync.Once
+	dbErr  interface{}
+)
+
+// initDB 初始化数据库，数据库不可用时跳过测试，不影响同一个包中不依赖数据库的测试
+func initDB(t *testing.T) {
+	dbOnce.Do(func() {
+		defer func() { dbErr = recover() }()
+		db.InitByTest()
+	})
+	if dbErr != nil {
+		t.Skip("db not available:", dbErr)
+	}
+}
+
+func TestDeliveryRepo_Save(t *testing.T) {
+	initDB(t)
+	fmt.Println(DeliveryRepo.Save(&Delivery{
+		EventId:   1,
+		EventType: EventMessageSent,
+		Url:       "http://127.0.0.1:8000/webhook",
+		Payload:   []byte("{}"),
+		NextTime:  time.Now(),
+	}))
+}
+
+func TestDeliveryRepo_ListDue(t *testing.T) {
+	initDB(t)
+	deliveries, err := DeliveryRepo.ListDue(time.Now(), 10)
+	fmt.Printf("%+v\n %+v\n", deliveries, err)
+}
+
+func TestDeliveryRepo_ListDead(t *testing.T) {
+	initDB(t)
+	deliveries, err := DeliveryRepo.ListDead(10)
+	fmt.Printf("%+v\n %+v\n", deliveries, err)
+}
+
+func TestDeliveryRepo_DeleteSucceeded(t *testing.T) {
+	initDB(t)
+	fmt.Println(DeliveryRepo.DeleteSucceeded(time.Now().Add(-time.Hour), 10))
+}
@@ -0,0 +1,72 @@
+package webhook
+
+const (
+	EventMessageSent        = "message.sent"         // 发送消息
+	EventMessageRecalled    = "message.recalled"     // 撤回消息
+	EventFriendAdded        = "friend.added"         // 添加好友
+	EventGroupCreated       = "group.created"        // 创建群组
+	EventGroupUpdated       = "group.updated"        // 更新群组
+	EventGroupMemberAdded   = "group.member_added"   // 添加群组成员
+	EventGroupMemberRemoved = "group.member_removed" // 移除群组成员
+	EventDeviceOnline       = "device.online"        // 设备上线
+	EventDeviceOffline      = "device.offline"       // 设备离线
+)
+
+// Event 推送给业务服务的事件，序列化为JSON后作为HTTP请求体
+type Event struct {
+	EventId   int64       `json:"event_id"`   // 事件id，重试时不变，业务服务可以用来去重
+	EventType string      `json:"event_type"` // 事件类型
+	Timestamp int64       `json:"timestamp"`  // 事件发生时间戳，精确到毫秒
+	Data      interface{} `json:"data"`       // 事件内容
+}
+
+// MessageSentData 发送消息事件的内容
+type MessageSentData struct {
+	MessageId      int64  `json:"message_id"`      // 消息id
+	SenderType     int32  `json:"sender_type"`     // 发送者类型
+	SenderId       int64  `json:"sender_id"`       // 发送者id
+	ReceiverType   int32  `json:"receiver_type"`   // 接收者类型
+	ReceiverId     int64  `json:"receiver_id"`     // 用户id或者群组id
+	MessageType    int32  `json:"message_type"`    // 消息类型
+	MessageContent []byte `json:"message_content"` // 消息内容，base64编码，阅后即焚消息为空
+	SendTime       int64  `json:"send_time"`       // 消息发送时间戳，精确到毫秒
+}
+
+// MessageRecalledData 撤回消息事件的内容
+type MessageRecalledData struct {
+	MessageId    int64 `json:"message_id"`    // 消息id
+	OptId        int64 `json:"opt_id"`        // 操作人用户id
+	ReceiverType int32 `json:"receiver_type"` // 接收者类型
+	ReceiverId   int64 `json:"receiver_id"`   // 用户id或者群组id
+	RecallTime   int64 `json:"recall_time"`   // 撤回时间戳，精确到毫秒
+}
+
+// FriendAddedData 添加好友事件的内容
+type FriendAddedData struct {
+	UserId   int64 `json:"user_id"`   // 同意添加好友的用户id
+	FriendId int64 `json:"friend_id"` // 发起申请的用户id
+}
+
+// GroupData 创建、更新群组事件的内容
+type GroupData struct {
+	GroupId      int64  `json:"group_id"`     // 群组id
+	OptId        int64  `json:"opt_id"`       // 操作人用户id
+	Name         string `json:"name"`         // 群组名称
+	AvatarUrl    string `json:"avatar_url"`   // 群组头像
+	Introduction string `json:"introduction"` // 群组简介
+	Extra        string `json:"extra"`        // 附加字段
+}
+
+// GroupMemberData 添加、移除群组成员事件的内容
+type GroupMemberData struct {
+	GroupId int64   `json:"group_id"` // 群组id
+	OptId   int64   `json:"opt_id"`   // 操作人用户id
+	UserIds []int64 `json:"user_ids"` // 被添加或者移除的成员id
+}
+
+// DeviceData 设备上线、离线事件的内容
+type DeviceData struct {
+	DeviceId   int64  `json:"device_id"`   // 设备id
+	UserId     int64  `json:"user_id"`     // 用户id
+	ClientAddr string `json:"client_addr"` // 客户端地址
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+const (
+	HeaderEvent     = "X-Gim-Event"     // 事件类型
+	HeaderTimestamp = "X-Gim-Timestamp" // 签名时间戳，精确到秒
+	HeaderSignature = "X-Gim-Signature" // 签名
+)
+
+// Sign 计算签名，hex(HMAC-SHA256(secret, timestamp + "." + body))，业务服务使用同样的方法验证请求
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send 将事件POST到接收地址，响应码不是2xx时返回error
+func Send(ctx context.Context, client *http.Client, url, secret, eventType string, timestamp int64, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// 读完响应体，连接才能复用
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook response status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSend(t *testing.T) {
+	const secret = "secret"
+	body := []byte(`{"event_id":1}`)
+
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+		data, _ := ioutil.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		if r.Header.Get(HeaderEvent) != EventMessageSent || r.Header.Get(HeaderSignature) != Sign(secret, timestamp, data) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := Send(context.TODO(), server.Client(), server.URL, secret, EventMessageSent, time.Now().Unix(), body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 秘钥不一致，签名验证失败
+	err = Send(context.TODO(), server.Client(), server.URL, "other", EventMessageSent, time.Now().Unix(), body)
+	if err == nil {
+		t.Fatal("expected error for bad signature")
+	}
+	if received != 2 {
+		t.Fatalf("received = %d", received)
+	}
+}
+
+func TestSend_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	err := Send(ctx, server.Client(), server.URL, "secret", EventMessageSent, time.Now().Unix(), []byte("{}"))
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expect   time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{5, 16 * time.Second},
+		{10, time.Minute},
+		{100, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts, time.Second, time.Minute); got != tt.expect {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.expect)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"gim/config"
+	"gim/pkg/gerrors"
+	"gim/pkg/logger"
+	"gim/pkg/util"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DeliverLimit = 100  // 每次扫描最多投递的记录数
+	CleanLimit   = 1000 // 每次最多删除的投递成功的记录数
+	MaxErrorLen  = 1024 // 保存的失败原因的最大长度
+)
+
+type webhookService struct {
+	client *http.Client
+}
+
+var WebhookService = &webhookService{client: &http.Client{}}
+
+// getEndpoints 获取订阅了事件的接收地址
+func getEndpoints(eventType string) []config.WebhookEndpoint {
+	var endpoints []config.WebhookEndpoint
+	for _, endpoint := range config.Logic.WebhookEndpoints {
+		if len(endpoint.Events) == 0 {
+			endpoints = append(endpoints, endpoint)
+			continue
+		}
+		for _, event := range endpoint.Events {
+			if event == eventType {
+				endpoints = append(endpoints, endpoint)
+				break
+			}
+		}
+	}
+	return endpoints
+}
+
+// getEndpoint 根据接收地址获取配置，地址已经从配置中删除时返回nil
+func getEndpoint(url string) *config.WebhookEndpoint {
+	for i := range config.Logic.WebhookEndpoints {
+		if config.Logic.WebhookEndpoints[i].Url == url {
+			return &config.Logic.WebhookEndpoints[i]
+		}
+	}
+	return nil
+}
+
+// Backoff 第attempts次投递失败后，到下次投递的间隔，从min开始每次翻倍，不超过max
+func Backoff(attempts int, min, max time.Duration) time.Duration {
+	backoff := min
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= max {
+			return max
+		}
+	}
+	return backoff
+}
+
+// Publish 发布事件，事件先保存到投递队列中，再由Deliver异步投递，发布失败只记录日志，不影响业务流程
+func (*webhookService) Publish(ctx context.Context, eventType string, data interface{}) {
+	endpoints := getEndpoints(eventType)
+	if len(endpoints) == 0 {
+		return
+	}
+
+	err := publish(eventType, data, endpoints)
+	if err != nil {
+		logger.Logger.Error("publish webhook event error", zap.String("event_type", eventType), zap.Any("data", data), zap.Error(err))
+	}
+}
+
+func publish(eventType string, data interface{}, endpoints []config.WebhookEndpoint) error {
+	eventId, err := util.EventIdUid.Get()
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(Event{
+		EventId:   eventId,
+		EventType: eventType,
+		Timestamp: util.UnixMilliTime(now),
+		Data:      data,
+	})
+	if err != nil {
+		return gerrors.WrapError(err)
+	}
+
+	for _, endpoint := range endpoints {
+		err = DeliveryRepo.Save(&Delivery{
+			EventId:    eventId,
+			EventType:  eventType,
+			Url:        endpoint.Url,
+			Payload:    payload,
+			Status:     DeliveryPending,
+			NextTime:   now,
+			CreateTime: now,
+			UpdateTime: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deliver 投递到了投递时间的事件
+func (s *webhookService) Deliver(ctx context.Context) error {
+	now := time.Now()
+	deliveries, err := DeliveryRepo.ListDue(now, DeliverLimit)
+	if err != nil {
+		return err
+	}
+
+	for i := range deliveries {
+		// 投递期间，其他实例不会再投递这条记录，记录是依次投递的，租期需要从领取时开始计算
+		ok, err := DeliveryRepo.Claim(&deliveries[i], time.Now().Add(2*config.Logic.WebhookTimeout))
+		if err != nil {
+			logger.Logger.Error("claim webhook delivery error", zap.Int64("id", deliveries[i].Id), zap.Error(err))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		s.deliver(ctx, &deliveries[i])
+	}
+	return nil
+}
+
+// Clean 删除超过保留时长的投递成功的记录，记录中包含完整的事件内容，不能一直保留，死信记录保留用于人工处理
+func (*webhookService) Clean(ctx context.Context) (int64, error) {
+	if config.Logic.WebhookRetention <= 0 {
+		return 0, nil
+	}
+
+	before := time.Now().Add(-config.Logic.WebhookRetention)
+	var total int64
+	for {
+		count, err := DeliveryRepo.DeleteSucceeded(before, CleanLimit)
+		if err != nil {
+			return total, err
+		}
+		total += count
+		if count < CleanLimit {
+			return total, nil
+		}
+	}
+}
+
+// deliveryResult 一次投递的结果
+type deliveryResult struct {
+	Status    int32     // 投递之后的状态
+	Attempts  int       // 已经投递的次数
+	NextTime  time.Time // 下次投递时间
+	LastError string    // 投递失败的原因
+}
+
+func (s *webhookService) deliver(ctx context.Context, delivery *Delivery) {
+	result := s.attempt(ctx, delivery)
+	err := DeliveryRepo.Finish(delivery.Id, result.Status, result.Attempts, result.NextTime, result.LastError)
+	if err != nil {
+		logger.Logger.Error("finish webhook delivery error", zap.Int64("id", delivery.Id), zap.Error(err))
+	}
+}
+
+// attempt 投递一次，失败时按照退避间隔重试，超过最大投递次数或者地址已经从配置中删除时记录为死信
+func (s *webhookService) attempt(ctx context.Context, delivery *Delivery) deliveryResult {
+	result := deliveryResult{Status: DeliverySucceeded, Attempts: delivery.Attempts + 1}
+
+	var err error
+	endpoint := getEndpoint(delivery.Url)
+	if endpoint == nil {
+		err = gerrors.ErrBadRequest
+	} else {
+		sendCtx, cancel := context.WithTimeout(ctx, config.Logic.WebhookTimeout)
+		err = Send(sendCtx, s.client, endpoint.Url, endpoint.Secret, delivery.EventType, time.Now().Unix(), delivery.Payload)
+		cancel()
+	}
+
+	result.NextTime = time.Now()
+	if err == nil {
+		return result
+	}
+
+	result.LastError = err.Error()
+	if len(result.LastError) > MaxErrorLen {
+		result.LastError = result.LastError[:MaxErrorLen]
+	}
+	result.Status = DeliveryPending
+	result.NextTime = result.NextTime.Add(Backoff(result.Attempts, config.Logic.WebhookMinBackoff, config.Logic.WebhookMaxBackoff))
+	// 地址已经从配置中删除，或者超过最大投递次数，记录为死信
+	if endpoint == nil || result.Attempts >= config.Logic.WebhookMaxAttempts {
+		result.Status = DeliveryDead
+		logger.Logger.Warn("webhook delivery dead", zap.Int64("id", delivery.Id), zap.Int64("event_id", delivery.EventId),
+			zap.String("url", delivery.Url), zap.String("error", result.LastError))
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"context"
+	"gim/config"
+	"gim/pkg/logger"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhookService_Attempt(t *testing.T) {
+	logger.Init()
+
+	fail := true
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	old := config.Logic
+	defer func() { config.Logic = old }()
+	config.Logic.WebhookEndpoints = []config.WebhookEndpoint{{Url: server.URL, Secret: "secret"}}
+	config.Logic.WebhookTimeout = time.Second
+	config.Logic.WebhookMaxAttempts = 3
+	config.Logic.WebhookMinBackoff = time.Second
+	config.Logic.WebhookMaxBackoff = time.Minute
+
+	service := &webhookService{client: server.Client()}
+	delivery := &Delivery{Id: 1, EventId: 1, EventType: EventMessageSent, Url: server.URL, Payload: []byte("{}")}
+
+	// 投递失败，按照退避间隔重试
+	for attempts, backoff := range []time.Duration{time.Second, 2 * time.Second} {
+		start := time.Now()
+		result := service.attempt(context.TODO(), delivery)
+		if result.Status != DeliveryPending || result.Attempts != attempts+1 || result.LastError == "" {
+			t.Fatalf("attempt %d: result = %+v", attempts+1, result)
+		}
+		if result.NextTime.Before(start.Add(backoff)) || result.NextTime.After(time.Now().Add(backoff)) {
+			t.Fatalf("attempt %d: next time = %v, want about %v later", attempts+1, result.NextTime, backoff)
+		}
+		delivery.Attempts = result.Attempts
+	}
+
+	// 超过最大投递次数，记录为死信
+	result := service.attempt(context.TODO(), delivery)
+	if result.Status != DeliveryDead || result.Attempts != 3 {
+		t.Fatalf("result = %+v", result)
+	}
+
+	// 投递成功
+	fail = false
+	delivery.Attempts = 1
+	result = service.attempt(context.TODO(), delivery)
+	if result.Status != DeliverySucceeded || result.Attempts != 2 || result.LastError != "" {
+		t.Fatalf("result = %+v", result)
+	}
+	if received != 4 {
+		t.Fatalf("received = %d", received)
+	}
+
+	// 地址已经从配置中删除，直接记录为死信
+	config.Logic.WebhookEndpoints = nil
+	result = service.attempt(context.TODO(), delivery)
+	if result.Status != DeliveryDead || received != 4 {
+		t.Fatalf("result = %+v, received = %d", result, received)
+	}
+}
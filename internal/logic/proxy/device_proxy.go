@@ -7,6 +7,7 @@ import (
 
 type deviceProxy interface {
 	ListOnlineByUserId(ctx context.Context, userId int64) ([]*pb.Device, error)
+	ListOnlineByUserIds(ctx context.Context, userIds []int64) (map[int64][]*pb.Device, error)
 }
 
 var DeviceProxy deviceProxy
@@ -10,6 +10,8 @@ import (
 var MessageProxy messageProxy
 
 type messageProxy interface {
-	SendToUser(ctx context.Context, sender *pb.Sender, toUserId int64, req *pb.SendMessageReq) (int64, error)
+	SendToUser(ctx context.Context, messageId int64, sender *pb.Sender, toUserId int64, req *pb.SendMessageReq) (int64, error)
+	SendToUsers(ctx context.Context, messageId int64, sender *pb.Sender, toUserIds []int64, req *pb.SendMessageReq) []int64
+	NewMessageId() (int64, error)
 	PushToUser(ctx context.Context, userId int64, code pb.PushCode, message proto.Message, isPersist bool) error
 }
@@ -10,14 +10,44 @@ var (
 	ErrUnauthorized = newError(10000, "请重新登录")
 	ErrBadRequest   = newError(10001, "请求参数错误")
 
-	ErrBadCode         = newError(10010, "验证码错误")
-	ErrNotInGroup      = newError(10011, "用户没有在群组中")
-	ErrGroupNotExist   = newError(10013, "群组不存在")
-	ErrDeviceNotExist  = newError(10014, "设备不存在")
-	ErrAlreadyIsFriend = newError(10015, "对方已经是好友了")
-	ErrUserNotFound    = newError(10016, "用户找不到")
+	ErrBadCode           = newError(10010, "验证码错误")
+	ErrNotInGroup        = newError(10011, "用户没有在群组中")
+	ErrGroupNotExist     = newError(10013, "群组不存在")
+	ErrDeviceNotExist    = newError(10014, "设备不存在")
+	ErrAlreadyIsFriend   = newError(10015, "对方已经是好友了")
+	ErrUserNotFound      = newError(10016, "用户找不到")
+	ErrMessageNotFound   = newError(10017, "消息不存在")
+	ErrNotMessageOwner   = newError(10018, "只能操作自己发送的消息")
+	ErrRecallTimeout     = newError(10019, "消息已超过可撤回时间")
+	ErrMessageSending    = newError(10020, "消息正在发送中")
+	ErrMessageRecalled   = newError(10021, "消息已撤回")
+	ErrEditTimeout       = newError(10022, "消息已超过可编辑时间")
+	ErrScheduleNotFound  = newError(10023, "定时消息不存在或者已经发送")
+	ErrTooFrequent       = newError(10024, "操作过于频繁")
+	ErrNotIsFriend       = newError(10025, "对方不是你的好友")
+	ErrBadMessageContent = newError(10026, "消息内容不合法")
+	ErrContentRejected   = newError(10027, "消息内容违规")
+	ErrSendRejected      = newError(10028, "消息不允许发送")
+	ErrNotGroupAdmin     = newError(10029, "只有群组管理员可以操作")
+	ErrMentionNotInGroup = newError(10030, "被@的用户不在群组中")
 )
 
+// NewContentRejected 消息审核不通过，reason为拒绝原因，为空时使用默认提示
+func NewContentRejected(reason string) error {
+	if reason == "" {
+		return ErrContentRejected
+	}
+	return newError(10027, reason)
+}
+
+// NewSendRejected 业务服务拒绝发送消息，reason为拒绝原因，为空时使用默认提示
+func NewSendRejected(reason string) error {
+	if reason == "" {
+		return ErrSendRejected
+	}
+	return newError(10028, reason)
+}
+
 func newError(code int, message string) error {
 	return status.New(codes.Code(code), message).Err()
 }
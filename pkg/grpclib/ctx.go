@@ -20,6 +20,16 @@ func ContextWithRequestId(ctx context.Context, requestId int64) context.Context
 	return metadata.NewOutgoingContext(ctx, metadata.Pairs(CtxRequestId, strconv.FormatInt(requestId, 10)))
 }
 
+// ContextWithUserData 将用户信息写入ctx，用于以用户身份调用Ext服务
+func ContextWithUserData(ctx context.Context, requestId, userId, deviceId int64, token string) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs(
+		CtxRequestId, strconv.FormatInt(requestId, 10),
+		CtxUserId, strconv.FormatInt(userId, 10),
+		CtxDeviceId, strconv.FormatInt(deviceId, 10),
+		CtxToken, token,
+	))
+}
+
 // GetCtxRequestId 获取ctx的app_id
 func GetCtxRequestId(ctx context.Context) int64 {
 	md, ok := metadata.FromIncomingContext(ctx)
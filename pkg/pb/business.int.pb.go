@@ -181,46 +181,194 @@ func (x *GetUsersResp) GetUsers() map[int64]*User {
 	return nil
 }
 
+type BeforeSendMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender  *Sender         `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`   // 发送者
+	Message *SendMessageReq `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 消息
+}
+
+func (x *BeforeSendMessageReq) Reset() {
+	*x = BeforeSendMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_business_int_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeforeSendMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeforeSendMessageReq) ProtoMessage() {}
+
+func (x *BeforeSendMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_business_int_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeforeSendMessageReq.ProtoReflect.Descriptor instead.
+func (*BeforeSendMessageReq) Descriptor() ([]byte, []int) {
+	return file_business_int_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BeforeSendMessageReq) GetSender() *Sender {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *BeforeSendMessageReq) GetMessage() *SendMessageReq {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type BeforeSendMessageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsReject       bool   `protobuf:"varint,1,opt,name=is_reject,json=isReject,proto3" json:"is_reject,omitempty"`                  // 是否拒绝发送
+	Reason         string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                       // 拒绝原因，返回给发送者
+	MessageContent []byte `protobuf:"bytes,3,opt,name=message_content,json=messageContent,proto3" json:"message_content,omitempty"` // 修改后的消息内容，为空时不修改
+	Extra          string `protobuf:"bytes,4,opt,name=extra,proto3" json:"extra,omitempty"`                                         // 消息的附加字段，为空时不修改
+}
+
+func (x *BeforeSendMessageResp) Reset() {
+	*x = BeforeSendMessageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_business_int_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeforeSendMessageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeforeSendMessageResp) ProtoMessage() {}
+
+func (x *BeforeSendMessageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_business_int_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeforeSendMessageResp.ProtoReflect.Descriptor instead.
+func (*BeforeSendMessageResp) Descriptor() ([]byte, []int) {
+	return file_business_int_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BeforeSendMessageResp) GetIsReject() bool {
+	if x != nil {
+		return x.IsReject
+	}
+	return false
+}
+
+func (x *BeforeSendMessageResp) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BeforeSendMessageResp) GetMessageContent() []byte {
+	if x != nil {
+		return x.MessageContent
+	}
+	return nil
+}
+
+func (x *BeforeSendMessageResp) GetExtra() string {
+	if x != nil {
+		return x.Extra
+	}
+	return ""
+}
+
 var File_business_int_proto protoreflect.FileDescriptor
 
 var file_business_int_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x62, 0x75, 0x73, 0x69,
-	0x6e, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55,
-	0x0a, 0x07, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x37, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x1a, 0x3a,
-	0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x42,
-	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x32, 0x88, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49,
-	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0d, 0x5a,
-	0x0b, 0x67, 0x69, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x65, 0x73, 0x73, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x55, 0x0a, 0x07, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x37, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x85,
+	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x1a, 0x42, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x68, 0x0a, 0x14, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x22,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x8b, 0x01, 0x0a, 0x15, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x32, 0xd2,
+	0x01, 0x0a, 0x0b, 0x42, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x11, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x42, 0x0d, 0x5a, 0x0b, 0x67, 0x69, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x62, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -235,33 +383,41 @@ func file_business_int_proto_rawDescGZIP() []byte {
 	return file_business_int_proto_rawDescData
 }
 
-var file_business_int_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_business_int_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_business_int_proto_goTypes = []interface{}{
-	(*AuthReq)(nil),      // 0: pb.AuthReq
-	(*GetUsersReq)(nil),  // 1: pb.GetUsersReq
-	(*GetUsersResp)(nil), // 2: pb.GetUsersResp
-	nil,                  // 3: pb.GetUsersReq.UserIdsEntry
-	nil,                  // 4: pb.GetUsersResp.UsersEntry
-	(*User)(nil),         // 5: pb.User
-	(*GetUserReq)(nil),   // 6: pb.GetUserReq
-	(*Empty)(nil),        // 7: pb.Empty
-	(*GetUserResp)(nil),  // 8: pb.GetUserResp
+	(*AuthReq)(nil),               // 0: pb.AuthReq
+	(*GetUsersReq)(nil),           // 1: pb.GetUsersReq
+	(*GetUsersResp)(nil),          // 2: pb.GetUsersResp
+	(*BeforeSendMessageReq)(nil),  // 3: pb.BeforeSendMessageReq
+	(*BeforeSendMessageResp)(nil), // 4: pb.BeforeSendMessageResp
+	nil,                           // 5: pb.GetUsersReq.UserIdsEntry
+	nil,                           // 6: pb.GetUsersResp.UsersEntry
+	(*Sender)(nil),                // 7: pb.Sender
+	(*SendMessageReq)(nil),        // 8: pb.SendMessageReq
+	(*User)(nil),                  // 9: pb.User
+	(*GetUserReq)(nil),            // 10: pb.GetUserReq
+	(*Empty)(nil),                 // 11: pb.Empty
+	(*GetUserResp)(nil),           // 12: pb.GetUserResp
 }
 var file_business_int_proto_depIdxs = []int32{
-	3, // 0: pb.GetUsersReq.user_ids:type_name -> pb.GetUsersReq.UserIdsEntry
-	4, // 1: pb.GetUsersResp.users:type_name -> pb.GetUsersResp.UsersEntry
-	5, // 2: pb.GetUsersResp.UsersEntry.value:type_name -> pb.User
-	0, // 3: pb.BusinessInt.Auth:input_type -> pb.AuthReq
-	6, // 4: pb.BusinessInt.GetUser:input_type -> pb.GetUserReq
-	1, // 5: pb.BusinessInt.GetUsers:input_type -> pb.GetUsersReq
-	7, // 6: pb.BusinessInt.Auth:output_type -> pb.Empty
-	8, // 7: pb.BusinessInt.GetUser:output_type -> pb.GetUserResp
-	2, // 8: pb.BusinessInt.GetUsers:output_type -> pb.GetUsersResp
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5,  // 0: pb.GetUsersReq.user_ids:type_name -> pb.GetUsersReq.UserIdsEntry
+	6,  // 1: pb.GetUsersResp.users:type_name -> pb.GetUsersResp.UsersEntry
+	7,  // 2: pb.BeforeSendMessageReq.sender:type_name -> pb.Sender
+	8,  // 3: pb.BeforeSendMessageReq.message:type_name -> pb.SendMessageReq
+	9,  // 4: pb.GetUsersResp.UsersEntry.value:type_name -> pb.User
+	0,  // 5: pb.BusinessInt.Auth:input_type -> pb.AuthReq
+	10, // 6: pb.BusinessInt.GetUser:input_type -> pb.GetUserReq
+	1,  // 7: pb.BusinessInt.GetUsers:input_type -> pb.GetUsersReq
+	3,  // 8: pb.BusinessInt.BeforeSendMessage:input_type -> pb.BeforeSendMessageReq
+	11, // 9: pb.BusinessInt.Auth:output_type -> pb.Empty
+	12, // 10: pb.BusinessInt.GetUser:output_type -> pb.GetUserResp
+	2,  // 11: pb.BusinessInt.GetUsers:output_type -> pb.GetUsersResp
+	4,  // 12: pb.BusinessInt.BeforeSendMessage:output_type -> pb.BeforeSendMessageResp
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_business_int_proto_init() }
@@ -271,6 +427,8 @@ func file_business_int_proto_init() {
 	}
 	file_common_ext_proto_init()
 	file_business_ext_proto_init()
+	file_connect_ext_proto_init()
+	file_logic_ext_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_business_int_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthReq); i {
@@ -308,6 +466,30 @@ func file_business_int_proto_init() {
 				return nil
 			}
 		}
+		file_business_int_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeforeSendMessageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_business_int_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeforeSendMessageResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -315,7 +497,7 @@ func file_business_int_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_business_int_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -347,6 +529,8 @@ type BusinessIntClient interface {
 	GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserResp, error)
 	// 批量获取用户信息
 	GetUsers(ctx context.Context, in *GetUsersReq, opts ...grpc.CallOption) (*GetUsersResp, error)
+	// 消息发送前回调，可以拒绝发送、修改消息内容或者设置附加字段
+	BeforeSendMessage(ctx context.Context, in *BeforeSendMessageReq, opts ...grpc.CallOption) (*BeforeSendMessageResp, error)
 }
 
 type businessIntClient struct {
@@ -384,6 +568,15 @@ func (c *businessIntClient) GetUsers(ctx context.Context, in *GetUsersReq, opts
 	return out, nil
 }
 
+func (c *businessIntClient) BeforeSendMessage(ctx context.Context, in *BeforeSendMessageReq, opts ...grpc.CallOption) (*BeforeSendMessageResp, error) {
+	out := new(BeforeSendMessageResp)
+	err := c.cc.Invoke(ctx, "/pb.BusinessInt/BeforeSendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BusinessIntServer is the server API for BusinessInt service.
 type BusinessIntServer interface {
 	// 权限校验
@@ -392,6 +585,8 @@ type BusinessIntServer interface {
 	GetUser(context.Context, *GetUserReq) (*GetUserResp, error)
 	// 批量获取用户信息
 	GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error)
+	// 消息发送前回调，可以拒绝发送、修改消息内容或者设置附加字段
+	BeforeSendMessage(context.Context, *BeforeSendMessageReq) (*BeforeSendMessageResp, error)
 }
 
 // UnimplementedBusinessIntServer can be embedded to have forward compatible implementations.
@@ -407,6 +602,9 @@ func (*UnimplementedBusinessIntServer) GetUser(context.Context, *GetUserReq) (*G
 func (*UnimplementedBusinessIntServer) GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
 }
+func (*UnimplementedBusinessIntServer) BeforeSendMessage(context.Context, *BeforeSendMessageReq) (*BeforeSendMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendMessage not implemented")
+}
 
 func RegisterBusinessIntServer(s *grpc.Server, srv BusinessIntServer) {
 	s.RegisterService(&_BusinessInt_serviceDesc, srv)
@@ -466,6 +664,24 @@ func _BusinessInt_GetUsers_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BusinessInt_BeforeSendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeforeSendMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessIntServer).BeforeSendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BusinessInt/BeforeSendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessIntServer).BeforeSendMessage(ctx, req.(*BeforeSendMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BusinessInt_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.BusinessInt",
 	HandlerType: (*BusinessIntServer)(nil),
@@ -482,6 +698,10 @@ var _BusinessInt_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUsers",
 			Handler:    _BusinessInt_GetUsers_Handler,
 		},
+		{
+			MethodName: "BeforeSendMessage",
+			Handler:    _BusinessInt_BeforeSendMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "business.int.proto",
@@ -79,6 +79,108 @@ func (x *DeliverMessageReq) GetMessageSend() *MessageSend {
 	return nil
 }
 
+type DeliverMessagesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*DeliverMessageReq `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // 消息列表
+}
+
+func (x *DeliverMessagesReq) Reset() {
+	*x = DeliverMessagesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_int_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverMessagesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverMessagesReq) ProtoMessage() {}
+
+func (x *DeliverMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_int_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverMessagesReq.ProtoReflect.Descriptor instead.
+func (*DeliverMessagesReq) Descriptor() ([]byte, []int) {
+	return file_connect_int_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeliverMessagesReq) GetMessages() []*DeliverMessageReq {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type DeliverSignalReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceId int64         `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 设备id
+	Signal   *SignalOutput `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`                      // 信号
+}
+
+func (x *DeliverSignalReq) Reset() {
+	*x = DeliverSignalReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_connect_int_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverSignalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverSignalReq) ProtoMessage() {}
+
+func (x *DeliverSignalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_connect_int_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverSignalReq.ProtoReflect.Descriptor instead.
+func (*DeliverSignalReq) Descriptor() ([]byte, []int) {
+	return file_connect_int_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeliverSignalReq) GetDeviceId() int64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *DeliverSignalReq) GetSignal() *SignalOutput {
+	if x != nil {
+		return x.Signal
+	}
+	return nil
+}
+
 // 房间推送
 type PushRoomMsg struct {
 	state         protoimpl.MessageState
@@ -92,7 +194,7 @@ type PushRoomMsg struct {
 func (x *PushRoomMsg) Reset() {
 	*x = PushRoomMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_int_proto_msgTypes[1]
+		mi := &file_connect_int_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -105,7 +207,7 @@ func (x *PushRoomMsg) String() string {
 func (*PushRoomMsg) ProtoMessage() {}
 
 func (x *PushRoomMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_connect_int_proto_msgTypes[1]
+	mi := &file_connect_int_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -118,7 +220,7 @@ func (x *PushRoomMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushRoomMsg.ProtoReflect.Descriptor instead.
 func (*PushRoomMsg) Descriptor() ([]byte, []int) {
-	return file_connect_int_proto_rawDescGZIP(), []int{1}
+	return file_connect_int_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PushRoomMsg) GetRoomId() int64 {
@@ -147,7 +249,7 @@ type PushAllMsg struct {
 func (x *PushAllMsg) Reset() {
 	*x = PushAllMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_connect_int_proto_msgTypes[2]
+		mi := &file_connect_int_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -160,7 +262,7 @@ func (x *PushAllMsg) String() string {
 func (*PushAllMsg) ProtoMessage() {}
 
 func (x *PushAllMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_connect_int_proto_msgTypes[2]
+	mi := &file_connect_int_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,7 +275,7 @@ func (x *PushAllMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushAllMsg.ProtoReflect.Descriptor instead.
 func (*PushAllMsg) Descriptor() ([]byte, []int) {
-	return file_connect_int_proto_rawDescGZIP(), []int{2}
+	return file_connect_int_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PushAllMsg) GetMessageSend() *MessageSend {
@@ -196,22 +298,39 @@ var file_connect_int_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65,
-	0x6e, 0x64, 0x22, 0x5a, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x4d, 0x73,
-	0x67, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e,
-	0x64, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x22, 0x40,
-	0x0a, 0x0a, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x32, 0x0a, 0x0c,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
-	0x65, 0x6e, 0x64, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x64,
-	0x32, 0x40, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x12, 0x32,
-	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x42, 0x0d, 0x5a, 0x0b, 0x67, 0x69, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
-	0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x64, 0x22, 0x47, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x10, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0x5a, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x52, 0x6f,
+	0x6f, 0x6d, 0x4d, 0x73, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x6e, 0x64, 0x22, 0x40, 0x0a, 0x0a, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67,
+	0x12, 0x32, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x65, 0x6e, 0x64, 0x32, 0xa8, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
+	0x0d, 0x5a, 0x0b, 0x67, 0x69, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -226,25 +345,34 @@ func file_connect_int_proto_rawDescGZIP() []byte {
 	return file_connect_int_proto_rawDescData
 }
 
-var file_connect_int_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_connect_int_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_connect_int_proto_goTypes = []interface{}{
-	(*DeliverMessageReq)(nil), // 0: pb.DeliverMessageReq
-	(*PushRoomMsg)(nil),       // 1: pb.PushRoomMsg
-	(*PushAllMsg)(nil),        // 2: pb.PushAllMsg
-	(*MessageSend)(nil),       // 3: pb.MessageSend
-	(*Empty)(nil),             // 4: pb.Empty
+	(*DeliverMessageReq)(nil),  // 0: pb.DeliverMessageReq
+	(*DeliverMessagesReq)(nil), // 1: pb.DeliverMessagesReq
+	(*DeliverSignalReq)(nil),   // 2: pb.DeliverSignalReq
+	(*PushRoomMsg)(nil),        // 3: pb.PushRoomMsg
+	(*PushAllMsg)(nil),         // 4: pb.PushAllMsg
+	(*MessageSend)(nil),        // 5: pb.MessageSend
+	(*SignalOutput)(nil),       // 6: pb.SignalOutput
+	(*Empty)(nil),              // 7: pb.Empty
 }
 var file_connect_int_proto_depIdxs = []int32{
-	3, // 0: pb.DeliverMessageReq.message_send:type_name -> pb.MessageSend
-	3, // 1: pb.PushRoomMsg.message_send:type_name -> pb.MessageSend
-	3, // 2: pb.PushAllMsg.message_send:type_name -> pb.MessageSend
-	0, // 3: pb.ConnectInt.DeliverMessage:input_type -> pb.DeliverMessageReq
-	4, // 4: pb.ConnectInt.DeliverMessage:output_type -> pb.Empty
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 0: pb.DeliverMessageReq.message_send:type_name -> pb.MessageSend
+	0, // 1: pb.DeliverMessagesReq.messages:type_name -> pb.DeliverMessageReq
+	6, // 2: pb.DeliverSignalReq.signal:type_name -> pb.SignalOutput
+	5, // 3: pb.PushRoomMsg.message_send:type_name -> pb.MessageSend
+	5, // 4: pb.PushAllMsg.message_send:type_name -> pb.MessageSend
+	0, // 5: pb.ConnectInt.DeliverMessage:input_type -> pb.DeliverMessageReq
+	1, // 6: pb.ConnectInt.DeliverMessages:input_type -> pb.DeliverMessagesReq
+	2, // 7: pb.ConnectInt.DeliverSignal:input_type -> pb.DeliverSignalReq
+	7, // 8: pb.ConnectInt.DeliverMessage:output_type -> pb.Empty
+	7, // 9: pb.ConnectInt.DeliverMessages:output_type -> pb.Empty
+	7, // 10: pb.ConnectInt.DeliverSignal:output_type -> pb.Empty
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_connect_int_proto_init() }
@@ -268,7 +396,7 @@ func file_connect_int_proto_init() {
 			}
 		}
 		file_connect_int_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushRoomMsg); i {
+			switch v := v.(*DeliverMessagesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -280,6 +408,30 @@ func file_connect_int_proto_init() {
 			}
 		}
 		file_connect_int_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliverSignalReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_int_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushRoomMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_connect_int_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushAllMsg); i {
 			case 0:
 				return &v.state
@@ -298,7 +450,7 @@ func file_connect_int_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_connect_int_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -326,6 +478,10 @@ const _ = grpc.SupportPackageIsVersion6
 type ConnectIntClient interface {
 	//  消息投递
 	DeliverMessage(ctx context.Context, in *DeliverMessageReq, opts ...grpc.CallOption) (*Empty, error)
+	//  批量消息投递，同一个connect节点上的设备的消息合并成一次调用
+	DeliverMessages(ctx context.Context, in *DeliverMessagesReq, opts ...grpc.CallOption) (*Empty, error)
+	//  瞬时信号投递
+	DeliverSignal(ctx context.Context, in *DeliverSignalReq, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type connectIntClient struct {
@@ -345,10 +501,32 @@ func (c *connectIntClient) DeliverMessage(ctx context.Context, in *DeliverMessag
 	return out, nil
 }
 
+func (c *connectIntClient) DeliverMessages(ctx context.Context, in *DeliverMessagesReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.ConnectInt/DeliverMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectIntClient) DeliverSignal(ctx context.Context, in *DeliverSignalReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.ConnectInt/DeliverSignal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConnectIntServer is the server API for ConnectInt service.
 type ConnectIntServer interface {
 	//  消息投递
 	DeliverMessage(context.Context, *DeliverMessageReq) (*Empty, error)
+	//  批量消息投递，同一个connect节点上的设备的消息合并成一次调用
+	DeliverMessages(context.Context, *DeliverMessagesReq) (*Empty, error)
+	//  瞬时信号投递
+	DeliverSignal(context.Context, *DeliverSignalReq) (*Empty, error)
 }
 
 // UnimplementedConnectIntServer can be embedded to have forward compatible implementations.
@@ -358,6 +536,12 @@ type UnimplementedConnectIntServer struct {
 func (*UnimplementedConnectIntServer) DeliverMessage(context.Context, *DeliverMessageReq) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeliverMessage not implemented")
 }
+func (*UnimplementedConnectIntServer) DeliverMessages(context.Context, *DeliverMessagesReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverMessages not implemented")
+}
+func (*UnimplementedConnectIntServer) DeliverSignal(context.Context, *DeliverSignalReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverSignal not implemented")
+}
 
 func RegisterConnectIntServer(s *grpc.Server, srv ConnectIntServer) {
 	s.RegisterService(&_ConnectInt_serviceDesc, srv)
@@ -381,6 +565,42 @@ func _ConnectInt_DeliverMessage_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConnectInt_DeliverMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliverMessagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectIntServer).DeliverMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ConnectInt/DeliverMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectIntServer).DeliverMessages(ctx, req.(*DeliverMessagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectInt_DeliverSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliverSignalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectIntServer).DeliverSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ConnectInt/DeliverSignal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectIntServer).DeliverSignal(ctx, req.(*DeliverSignalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ConnectInt_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.ConnectInt",
 	HandlerType: (*ConnectIntServer)(nil),
@@ -389,6 +609,14 @@ var _ConnectInt_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeliverMessage",
 			Handler:    _ConnectInt_DeliverMessage_Handler,
 		},
+		{
+			MethodName: "DeliverMessages",
+			Handler:    _ConnectInt_DeliverMessages_Handler,
+		},
+		{
+			MethodName: "DeliverSignal",
+			Handler:    _ConnectInt_DeliverSignal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "connect.int.proto",
@@ -434,6 +434,132 @@ func (x *SubscribeRoomReq) GetConnAddr() string {
 	return ""
 }
 
+type SignalReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64        `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户id
+	DeviceId int64        `protobuf:"varint,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 设备id
+	Signal   *SignalInput `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal,omitempty"`                      // 信号
+}
+
+func (x *SignalReq) Reset() {
+	*x = SignalReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logic_int_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalReq) ProtoMessage() {}
+
+func (x *SignalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_logic_int_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalReq.ProtoReflect.Descriptor instead.
+func (*SignalReq) Descriptor() ([]byte, []int) {
+	return file_logic_int_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SignalReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SignalReq) GetDeviceId() int64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *SignalReq) GetSignal() *SignalInput {
+	if x != nil {
+		return x.Signal
+	}
+	return nil
+}
+
+type ConnSendMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户id
+	DeviceId int64           `protobuf:"varint,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 设备id
+	Message  *SendMessageReq `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                    // 消息
+}
+
+func (x *ConnSendMessageReq) Reset() {
+	*x = ConnSendMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_logic_int_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnSendMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnSendMessageReq) ProtoMessage() {}
+
+func (x *ConnSendMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_logic_int_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnSendMessageReq.ProtoReflect.Descriptor instead.
+func (*ConnSendMessageReq) Descriptor() ([]byte, []int) {
+	return file_logic_int_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConnSendMessageReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ConnSendMessageReq) GetDeviceId() int64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *ConnSendMessageReq) GetMessage() *SendMessageReq {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
 type PushAllReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -447,7 +573,7 @@ type PushAllReq struct {
 func (x *PushAllReq) Reset() {
 	*x = PushAllReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_logic_int_proto_msgTypes[6]
+		mi := &file_logic_int_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -460,7 +586,7 @@ func (x *PushAllReq) String() string {
 func (*PushAllReq) ProtoMessage() {}
 
 func (x *PushAllReq) ProtoReflect() protoreflect.Message {
-	mi := &file_logic_int_proto_msgTypes[6]
+	mi := &file_logic_int_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +599,7 @@ func (x *PushAllReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushAllReq.ProtoReflect.Descriptor instead.
 func (*PushAllReq) Descriptor() ([]byte, []int) {
-	return file_logic_int_proto_rawDescGZIP(), []int{6}
+	return file_logic_int_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PushAllReq) GetMessageType() MessageType {
@@ -508,7 +634,7 @@ type GetDeviceReq struct {
 func (x *GetDeviceReq) Reset() {
 	*x = GetDeviceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_logic_int_proto_msgTypes[7]
+		mi := &file_logic_int_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -521,7 +647,7 @@ func (x *GetDeviceReq) String() string {
 func (*GetDeviceReq) ProtoMessage() {}
 
 func (x *GetDeviceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_logic_int_proto_msgTypes[7]
+	mi := &file_logic_int_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +660,7 @@ func (x *GetDeviceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeviceReq.ProtoReflect.Descriptor instead.
 func (*GetDeviceReq) Descriptor() ([]byte, []int) {
-	return file_logic_int_proto_rawDescGZIP(), []int{7}
+	return file_logic_int_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetDeviceReq) GetDeviceId() int64 {
@@ -555,7 +681,7 @@ type GetDeviceResp struct {
 func (x *GetDeviceResp) Reset() {
 	*x = GetDeviceResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_logic_int_proto_msgTypes[8]
+		mi := &file_logic_int_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -568,7 +694,7 @@ func (x *GetDeviceResp) String() string {
 func (*GetDeviceResp) ProtoMessage() {}
 
 func (x *GetDeviceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_logic_int_proto_msgTypes[8]
+	mi := &file_logic_int_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +707,7 @@ func (x *GetDeviceResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeviceResp.ProtoReflect.Descriptor instead.
 func (*GetDeviceResp) Descriptor() ([]byte, []int) {
-	return file_logic_int_proto_rawDescGZIP(), []int{8}
+	return file_logic_int_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetDeviceResp) GetDevice() *Device {
@@ -613,7 +739,7 @@ type Device struct {
 func (x *Device) Reset() {
 	*x = Device{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_logic_int_proto_msgTypes[9]
+		mi := &file_logic_int_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -626,7 +752,7 @@ func (x *Device) String() string {
 func (*Device) ProtoMessage() {}
 
 func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_logic_int_proto_msgTypes[9]
+	mi := &file_logic_int_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +765,7 @@ func (x *Device) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Device.ProtoReflect.Descriptor instead.
 func (*Device) Descriptor() ([]byte, []int) {
-	return file_logic_int_proto_rawDescGZIP(), []int{9}
+	return file_logic_int_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Device) GetDeviceId() int64 {
@@ -737,7 +863,7 @@ type ServerStopReq struct {
 func (x *ServerStopReq) Reset() {
 	*x = ServerStopReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_logic_int_proto_msgTypes[10]
+		mi := &file_logic_int_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -750,7 +876,7 @@ func (x *ServerStopReq) String() string {
 func (*ServerStopReq) ProtoMessage() {}
 
 func (x *ServerStopReq) ProtoReflect() protoreflect.Message {
-	mi := &file_logic_int_proto_msgTypes[10]
+	mi := &file_logic_int_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +889,7 @@ func (x *ServerStopReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerStopReq.ProtoReflect.Descriptor instead.
 func (*ServerStopReq) Descriptor() ([]byte, []int) {
-	return file_logic_int_proto_rawDescGZIP(), []int{10}
+	return file_logic_int_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ServerStopReq) GetConnAddr() string {
@@ -824,77 +950,105 @@ var file_logic_int_proto_rawDesc = []byte{
 	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x10, 0x0a,
 	0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
 	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0x86, 0x01, 0x0a,
-	0x0a, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x0c, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x64, 0x22, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xde, 0x02, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61,
-	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x64, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x64, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
-	0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
-	0x6e, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x32, 0xc1, 0x03, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x63,
-	0x49, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x49,
-	0x6e, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x49,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x21, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6e,
-	0x63, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x2a, 0x0a, 0x0a, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x43, 0x4b,
-	0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x43, 0x4b,
-	0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x24,
-	0x0a, 0x07, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
-	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26,
-	0x0a, 0x08, 0x50, 0x75, 0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
-	0x50, 0x75, 0x73, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6c,
-	0x6c, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a,
-	0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x11, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x1a,
-	0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x0d, 0x5a, 0x0b, 0x67, 0x69,
-	0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0x6a, 0x0a, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0x78, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x12, 0x32, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xde, 0x02,
+	0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x64, 0x6b, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x64, 0x6b, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c,
+	0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x32, 0xaa, 0x05, 0x0a,
+	0x08, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x49, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0a, 0x43, 0x6f, 0x6e,
+	0x6e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x0a, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x41, 0x43, 0x4b, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x41, 0x43, 0x4b, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x07, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0d, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x06,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x3e, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x65, 0x6e,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x36, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x75, 0x73, 0x68,
+	0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x3f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x42, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6c, 0x6c,
+	0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a,
+	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x0d, 0x5a, 0x0b, 0x67, 0x69, 0x6d,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -909,55 +1063,70 @@ func file_logic_int_proto_rawDescGZIP() []byte {
 	return file_logic_int_proto_rawDescData
 }
 
-var file_logic_int_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_logic_int_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_logic_int_proto_goTypes = []interface{}{
-	(*ConnSignInReq)(nil),    // 0: pb.ConnSignInReq
-	(*SyncReq)(nil),          // 1: pb.SyncReq
-	(*SyncResp)(nil),         // 2: pb.SyncResp
-	(*MessageACKReq)(nil),    // 3: pb.MessageACKReq
-	(*OfflineReq)(nil),       // 4: pb.OfflineReq
-	(*SubscribeRoomReq)(nil), // 5: pb.SubscribeRoomReq
-	(*PushAllReq)(nil),       // 6: pb.PushAllReq
-	(*GetDeviceReq)(nil),     // 7: pb.GetDeviceReq
-	(*GetDeviceResp)(nil),    // 8: pb.GetDeviceResp
-	(*Device)(nil),           // 9: pb.Device
-	(*ServerStopReq)(nil),    // 10: pb.ServerStopReq
-	(*Message)(nil),          // 11: pb.Message
-	(MessageType)(0),         // 12: pb.MessageType
-	(*SendMessageReq)(nil),   // 13: pb.SendMessageReq
-	(*PushRoomReq)(nil),      // 14: pb.PushRoomReq
-	(*Empty)(nil),            // 15: pb.Empty
-	(*SendMessageResp)(nil),  // 16: pb.SendMessageResp
+	(*ConnSignInReq)(nil),             // 0: pb.ConnSignInReq
+	(*SyncReq)(nil),                   // 1: pb.SyncReq
+	(*SyncResp)(nil),                  // 2: pb.SyncResp
+	(*MessageACKReq)(nil),             // 3: pb.MessageACKReq
+	(*OfflineReq)(nil),                // 4: pb.OfflineReq
+	(*SubscribeRoomReq)(nil),          // 5: pb.SubscribeRoomReq
+	(*SignalReq)(nil),                 // 6: pb.SignalReq
+	(*ConnSendMessageReq)(nil),        // 7: pb.ConnSendMessageReq
+	(*PushAllReq)(nil),                // 8: pb.PushAllReq
+	(*GetDeviceReq)(nil),              // 9: pb.GetDeviceReq
+	(*GetDeviceResp)(nil),             // 10: pb.GetDeviceResp
+	(*Device)(nil),                    // 11: pb.Device
+	(*ServerStopReq)(nil),             // 12: pb.ServerStopReq
+	(*Message)(nil),                   // 13: pb.Message
+	(*SignalInput)(nil),               // 14: pb.SignalInput
+	(*SendMessageReq)(nil),            // 15: pb.SendMessageReq
+	(MessageType)(0),                  // 16: pb.MessageType
+	(*PushRoomReq)(nil),               // 17: pb.PushRoomReq
+	(*Empty)(nil),                     // 18: pb.Empty
+	(*CancelScheduledMessageReq)(nil), // 19: pb.CancelScheduledMessageReq
+	(*SendMessageResp)(nil),           // 20: pb.SendMessageResp
+	(*GetScheduledMessagesResp)(nil),  // 21: pb.GetScheduledMessagesResp
 }
 var file_logic_int_proto_depIdxs = []int32{
-	11, // 0: pb.SyncResp.messages:type_name -> pb.Message
-	12, // 1: pb.PushAllReq.message_type:type_name -> pb.MessageType
-	9,  // 2: pb.GetDeviceResp.device:type_name -> pb.Device
-	0,  // 3: pb.LogicInt.ConnSignIn:input_type -> pb.ConnSignInReq
-	1,  // 4: pb.LogicInt.Sync:input_type -> pb.SyncReq
-	3,  // 5: pb.LogicInt.MessageACK:input_type -> pb.MessageACKReq
-	4,  // 6: pb.LogicInt.Offline:input_type -> pb.OfflineReq
-	5,  // 7: pb.LogicInt.SubscribeRoom:input_type -> pb.SubscribeRoomReq
-	13, // 8: pb.LogicInt.SendMessage:input_type -> pb.SendMessageReq
-	14, // 9: pb.LogicInt.PushRoom:input_type -> pb.PushRoomReq
-	6,  // 10: pb.LogicInt.PushAll:input_type -> pb.PushAllReq
-	7,  // 11: pb.LogicInt.GetDevice:input_type -> pb.GetDeviceReq
-	10, // 12: pb.LogicInt.ServerStop:input_type -> pb.ServerStopReq
-	15, // 13: pb.LogicInt.ConnSignIn:output_type -> pb.Empty
-	2,  // 14: pb.LogicInt.Sync:output_type -> pb.SyncResp
-	15, // 15: pb.LogicInt.MessageACK:output_type -> pb.Empty
-	15, // 16: pb.LogicInt.Offline:output_type -> pb.Empty
-	15, // 17: pb.LogicInt.SubscribeRoom:output_type -> pb.Empty
-	16, // 18: pb.LogicInt.SendMessage:output_type -> pb.SendMessageResp
-	15, // 19: pb.LogicInt.PushRoom:output_type -> pb.Empty
-	15, // 20: pb.LogicInt.PushAll:output_type -> pb.Empty
-	8,  // 21: pb.LogicInt.GetDevice:output_type -> pb.GetDeviceResp
-	15, // 22: pb.LogicInt.ServerStop:output_type -> pb.Empty
-	13, // [13:23] is the sub-list for method output_type
-	3,  // [3:13] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	13, // 0: pb.SyncResp.messages:type_name -> pb.Message
+	14, // 1: pb.SignalReq.signal:type_name -> pb.SignalInput
+	15, // 2: pb.ConnSendMessageReq.message:type_name -> pb.SendMessageReq
+	16, // 3: pb.PushAllReq.message_type:type_name -> pb.MessageType
+	11, // 4: pb.GetDeviceResp.device:type_name -> pb.Device
+	0,  // 5: pb.LogicInt.ConnSignIn:input_type -> pb.ConnSignInReq
+	1,  // 6: pb.LogicInt.Sync:input_type -> pb.SyncReq
+	3,  // 7: pb.LogicInt.MessageACK:input_type -> pb.MessageACKReq
+	4,  // 8: pb.LogicInt.Offline:input_type -> pb.OfflineReq
+	5,  // 9: pb.LogicInt.SubscribeRoom:input_type -> pb.SubscribeRoomReq
+	6,  // 10: pb.LogicInt.Signal:input_type -> pb.SignalReq
+	7,  // 11: pb.LogicInt.ConnSendMessage:input_type -> pb.ConnSendMessageReq
+	15, // 12: pb.LogicInt.SendMessage:input_type -> pb.SendMessageReq
+	17, // 13: pb.LogicInt.PushRoom:input_type -> pb.PushRoomReq
+	18, // 14: pb.LogicInt.GetScheduledMessages:input_type -> pb.Empty
+	19, // 15: pb.LogicInt.CancelScheduledMessage:input_type -> pb.CancelScheduledMessageReq
+	8,  // 16: pb.LogicInt.PushAll:input_type -> pb.PushAllReq
+	9,  // 17: pb.LogicInt.GetDevice:input_type -> pb.GetDeviceReq
+	12, // 18: pb.LogicInt.ServerStop:input_type -> pb.ServerStopReq
+	18, // 19: pb.LogicInt.ConnSignIn:output_type -> pb.Empty
+	2,  // 20: pb.LogicInt.Sync:output_type -> pb.SyncResp
+	18, // 21: pb.LogicInt.MessageACK:output_type -> pb.Empty
+	18, // 22: pb.LogicInt.Offline:output_type -> pb.Empty
+	18, // 23: pb.LogicInt.SubscribeRoom:output_type -> pb.Empty
+	18, // 24: pb.LogicInt.Signal:output_type -> pb.Empty
+	20, // 25: pb.LogicInt.ConnSendMessage:output_type -> pb.SendMessageResp
+	20, // 26: pb.LogicInt.SendMessage:output_type -> pb.SendMessageResp
+	18, // 27: pb.LogicInt.PushRoom:output_type -> pb.Empty
+	21, // 28: pb.LogicInt.GetScheduledMessages:output_type -> pb.GetScheduledMessagesResp
+	18, // 29: pb.LogicInt.CancelScheduledMessage:output_type -> pb.Empty
+	18, // 30: pb.LogicInt.PushAll:output_type -> pb.Empty
+	10, // 31: pb.LogicInt.GetDevice:output_type -> pb.GetDeviceResp
+	18, // 32: pb.LogicInt.ServerStop:output_type -> pb.Empty
+	19, // [19:33] is the sub-list for method output_type
+	5,  // [5:19] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_logic_int_proto_init() }
@@ -1042,7 +1211,7 @@ func file_logic_int_proto_init() {
 			}
 		}
 		file_logic_int_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushAllReq); i {
+			switch v := v.(*SignalReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1054,7 +1223,7 @@ func file_logic_int_proto_init() {
 			}
 		}
 		file_logic_int_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeviceReq); i {
+			switch v := v.(*ConnSendMessageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1066,7 +1235,7 @@ func file_logic_int_proto_init() {
 			}
 		}
 		file_logic_int_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeviceResp); i {
+			switch v := v.(*PushAllReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1078,7 +1247,7 @@ func file_logic_int_proto_init() {
 			}
 		}
 		file_logic_int_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Device); i {
+			switch v := v.(*GetDeviceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1090,6 +1259,30 @@ func file_logic_int_proto_init() {
 			}
 		}
 		file_logic_int_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_logic_int_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Device); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_logic_int_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerStopReq); i {
 			case 0:
 				return &v.state
@@ -1108,7 +1301,7 @@ func file_logic_int_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_logic_int_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1144,10 +1337,18 @@ type LogicIntClient interface {
 	Offline(ctx context.Context, in *OfflineReq, opts ...grpc.CallOption) (*Empty, error)
 	// 订阅房间
 	SubscribeRoom(ctx context.Context, in *SubscribeRoomReq, opts ...grpc.CallOption) (*Empty, error)
+	// 发送瞬时信号
+	Signal(ctx context.Context, in *SignalReq, opts ...grpc.CallOption) (*Empty, error)
+	// 设备通过长连接发送消息
+	ConnSendMessage(ctx context.Context, in *ConnSendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error)
 	// 发送消息
 	SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error)
 	// 推送消息到房间
 	PushRoom(ctx context.Context, in *PushRoomReq, opts ...grpc.CallOption) (*Empty, error)
+	// 获取业务方等待发送的定时消息
+	GetScheduledMessages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetScheduledMessagesResp, error)
+	// 取消业务方的定时消息
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageReq, opts ...grpc.CallOption) (*Empty, error)
 	// 全服推送
 	PushAll(ctx context.Context, in *PushAllReq, opts ...grpc.CallOption) (*Empty, error)
 	// 获取设备信息
@@ -1209,6 +1410,24 @@ func (c *logicIntClient) SubscribeRoom(ctx context.Context, in *SubscribeRoomReq
 	return out, nil
 }
 
+func (c *logicIntClient) Signal(ctx context.Context, in *SignalReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.LogicInt/Signal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicIntClient) ConnSendMessage(ctx context.Context, in *ConnSendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error) {
+	out := new(SendMessageResp)
+	err := c.cc.Invoke(ctx, "/pb.LogicInt/ConnSendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *logicIntClient) SendMessage(ctx context.Context, in *SendMessageReq, opts ...grpc.CallOption) (*SendMessageResp, error) {
 	out := new(SendMessageResp)
 	err := c.cc.Invoke(ctx, "/pb.LogicInt/SendMessage", in, out, opts...)
@@ -1227,6 +1446,24 @@ func (c *logicIntClient) PushRoom(ctx context.Context, in *PushRoomReq, opts ...
 	return out, nil
 }
 
+func (c *logicIntClient) GetScheduledMessages(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetScheduledMessagesResp, error) {
+	out := new(GetScheduledMessagesResp)
+	err := c.cc.Invoke(ctx, "/pb.LogicInt/GetScheduledMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logicIntClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.LogicInt/CancelScheduledMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *logicIntClient) PushAll(ctx context.Context, in *PushAllReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/pb.LogicInt/PushAll", in, out, opts...)
@@ -1266,10 +1503,18 @@ type LogicIntServer interface {
 	Offline(context.Context, *OfflineReq) (*Empty, error)
 	// 订阅房间
 	SubscribeRoom(context.Context, *SubscribeRoomReq) (*Empty, error)
+	// 发送瞬时信号
+	Signal(context.Context, *SignalReq) (*Empty, error)
+	// 设备通过长连接发送消息
+	ConnSendMessage(context.Context, *ConnSendMessageReq) (*SendMessageResp, error)
 	// 发送消息
 	SendMessage(context.Context, *SendMessageReq) (*SendMessageResp, error)
 	// 推送消息到房间
 	PushRoom(context.Context, *PushRoomReq) (*Empty, error)
+	// 获取业务方等待发送的定时消息
+	GetScheduledMessages(context.Context, *Empty) (*GetScheduledMessagesResp, error)
+	// 取消业务方的定时消息
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageReq) (*Empty, error)
 	// 全服推送
 	PushAll(context.Context, *PushAllReq) (*Empty, error)
 	// 获取设备信息
@@ -1297,12 +1542,24 @@ func (*UnimplementedLogicIntServer) Offline(context.Context, *OfflineReq) (*Empt
 func (*UnimplementedLogicIntServer) SubscribeRoom(context.Context, *SubscribeRoomReq) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscribeRoom not implemented")
 }
+func (*UnimplementedLogicIntServer) Signal(context.Context, *SignalReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signal not implemented")
+}
+func (*UnimplementedLogicIntServer) ConnSendMessage(context.Context, *ConnSendMessageReq) (*SendMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnSendMessage not implemented")
+}
 func (*UnimplementedLogicIntServer) SendMessage(context.Context, *SendMessageReq) (*SendMessageResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
 func (*UnimplementedLogicIntServer) PushRoom(context.Context, *PushRoomReq) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushRoom not implemented")
 }
+func (*UnimplementedLogicIntServer) GetScheduledMessages(context.Context, *Empty) (*GetScheduledMessagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMessages not implemented")
+}
+func (*UnimplementedLogicIntServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
 func (*UnimplementedLogicIntServer) PushAll(context.Context, *PushAllReq) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushAll not implemented")
 }
@@ -1407,6 +1664,42 @@ func _LogicInt_SubscribeRoom_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogicInt_Signal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicIntServer).Signal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LogicInt/Signal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicIntServer).Signal(ctx, req.(*SignalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicInt_ConnSendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnSendMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicIntServer).ConnSendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LogicInt/ConnSendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicIntServer).ConnSendMessage(ctx, req.(*ConnSendMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LogicInt_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendMessageReq)
 	if err := dec(in); err != nil {
@@ -1443,6 +1736,42 @@ func _LogicInt_PushRoom_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogicInt_GetScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicIntServer).GetScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LogicInt/GetScheduledMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicIntServer).GetScheduledMessages(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogicInt_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogicIntServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LogicInt/CancelScheduledMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogicIntServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LogicInt_PushAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PushAllReq)
 	if err := dec(in); err != nil {
@@ -1521,6 +1850,14 @@ var _LogicInt_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubscribeRoom",
 			Handler:    _LogicInt_SubscribeRoom_Handler,
 		},
+		{
+			MethodName: "Signal",
+			Handler:    _LogicInt_Signal_Handler,
+		},
+		{
+			MethodName: "ConnSendMessage",
+			Handler:    _LogicInt_ConnSendMessage_Handler,
+		},
 		{
 			MethodName: "SendMessage",
 			Handler:    _LogicInt_SendMessage_Handler,
@@ -1529,6 +1866,14 @@ var _LogicInt_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PushRoom",
 			Handler:    _LogicInt_PushRoom_Handler,
 		},
+		{
+			MethodName: "GetScheduledMessages",
+			Handler:    _LogicInt_GetScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _LogicInt_CancelScheduledMessage_Handler,
+		},
 		{
 			MethodName: "PushAll",
 			Handler:    _LogicInt_PushAll_Handler,
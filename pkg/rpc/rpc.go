@@ -16,6 +16,10 @@ var (
 	LogicIntClient    pb.LogicIntClient
 	ConnectIntClient  pb.ConnectIntClient
 	BusinessIntClient pb.BusinessIntClient
+
+	// LogicExtConn、BusinessExtConn 用于connect服务通过长连接转发客户端的接口调用
+	LogicExtConn    *grpc.ClientConn
+	BusinessExtConn *grpc.ClientConn
 )
 
 func InitLogicIntClient(addr string) {
@@ -50,3 +54,25 @@ func InitBusinessIntClient(addr string) {
 
 	BusinessIntClient = pb.NewBusinessIntClient(conn)
 }
+
+func InitLogicExtConn(addr string) {
+	conn, err := grpc.DialContext(context.TODO(), addr, grpc.WithInsecure(), grpc.WithUnaryInterceptor(interceptor),
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, roundrobin.Name)))
+	if err != nil {
+		logger.Sugar.Error(err)
+		panic(err)
+	}
+
+	LogicExtConn = conn
+}
+
+func InitBusinessExtConn(addr string) {
+	conn, err := grpc.DialContext(context.TODO(), addr, grpc.WithInsecure(), grpc.WithUnaryInterceptor(interceptor),
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, roundrobin.Name)))
+	if err != nil {
+		logger.Sugar.Error(err)
+		panic(err)
+	}
+
+	BusinessExtConn = conn
+}
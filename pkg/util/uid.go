@@ -7,12 +7,15 @@ import (
 )
 
 var (
-	MessageBodyIdUid *uid.Uid
-	DeviceIdUid      *uid.Uid
+	MessageIdUid *uid.Uid
+	DeviceIdUid  *uid.Uid
+	EventIdUid   *uid.Uid
 )
 
 const (
-	DeviceIdBusinessId = "device_id" // 设备id
+	DeviceIdBusinessId  = "device_id"  // 设备id
+	MessageIdBusinessId = "message_id" // 消息id
+	EventIdBusinessId   = "event_id"   // webhook事件id
 )
 
 func InitUID(db *sql.DB) {
@@ -22,4 +25,16 @@ func InitUID(db *sql.DB) {
 		logger.Sugar.Error(err)
 		panic(err)
 	}
+
+	MessageIdUid, err = uid.NewUid(db, MessageIdBusinessId, 1000)
+	if err != nil {
+		logger.Sugar.Error(err)
+		panic(err)
+	}
+
+	EventIdUid, err = uid.NewUid(db, EventIdBusinessId, 1000)
+	if err != nil {
+		logger.Sugar.Error(err)
+		panic(err)
+	}
 }
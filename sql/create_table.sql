@@ -103,6 +103,26 @@ CREATE TABLE `user`
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_bin COMMENT ='用户';
 
+-- ----------------------------
+-- Table structure for uid
+-- ----------------------------
+DROP TABLE IF EXISTS `uid`;
+CREATE TABLE `uid`
+(
+    `id`          bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `business_id` varchar(128) NOT NULL COMMENT '业务id',
+    `max_id`      bigint unsigned NOT NULL DEFAULT '0' COMMENT '已经分配的最大id',
+    `step`        int unsigned NOT NULL DEFAULT '1000' COMMENT '每次分配的id段长度',
+    `create_time` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_business_id` (`business_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='分布式自增id';
+
+INSERT INTO `uid` (`business_id`, `max_id`, `step`) VALUES ('device_id', 0, 1000);
+INSERT INTO `uid` (`business_id`, `max_id`, `step`) VALUES ('message_id', 0, 1000);
+INSERT INTO `uid` (`business_id`, `max_id`, `step`) VALUES ('event_id', 0, 1000);
+
 CREATE TABLE `seq`
 (
     `id`          bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
@@ -133,12 +153,200 @@ CREATE TABLE `message_000`
     `type`          tinyint(4) NOT NULL COMMENT '消息类型',
     `content`       blob         NOT NULL COMMENT '消息内容',
     `seq`           bigint(20) unsigned NOT NULL COMMENT '消息序列号',
+    `message_id`    bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '消息id，同一条消息的所有副本共用',
     `send_time`     datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP (3) COMMENT '消息发送时间',
     `status`        tinyint(255) NOT NULL DEFAULT '0' COMMENT '消息状态，0：未处理1：消息撤回',
+    `reply_to`      blob                NULL COMMENT '引用回复的消息快照',
+    `thread_root_id` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '所属话题根消息的id，0表示不在话题中',
+    `reply_count`   int(11) NOT NULL DEFAULT '0' COMMENT '话题回复数',
+    `last_reply_time` datetime(3)       NULL COMMENT '话题最后回复时间',
+    `edit_time`     datetime(3)         NULL COMMENT '最后编辑时间，为空表示没有编辑过',
+    `is_deleted`    tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否被用户从自己的消息列表中删除',
+    `ttl`           int(11) NOT NULL DEFAULT '0' COMMENT '阅后即焚时长，单位秒，0表示不是阅后即焚消息',
+    `expire_time`   datetime(3)         NULL COMMENT '阅后即焚消息的销毁时间，销毁后置为空',
+    `extra`         varchar(1024) NOT NULL DEFAULT '' COMMENT '附加字段',
+    `is_mention_all` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否@所有人',
+    `is_mentioned`  tinyint(1) NOT NULL DEFAULT '0' COMMENT '消息所属用户是否被@',
     `create_time`   datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
     `update_time`   datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
     PRIMARY KEY (`id`),
-    UNIQUE KEY `uk_user_id_seq` (`user_id`, `seq`) USING BTREE
+    UNIQUE KEY `uk_user_id_seq` (`user_id`, `seq`) USING BTREE,
+    KEY             `idx_user_id_message_id` (`user_id`, `message_id`) USING BTREE,
+    KEY             `idx_message_id` (`message_id`) USING BTREE,
+    KEY             `idx_user_id_receiver_seq` (`user_id`, `receiver_type`, `receiver_id`, `seq`) USING BTREE,
+    KEY             `idx_user_id_sender_seq` (`user_id`, `sender_id`, `seq`) USING BTREE,
+    KEY             `idx_user_id_thread_root_id_seq` (`user_id`, `thread_root_id`, `seq`) USING BTREE,
+    KEY             `idx_user_id_is_mentioned_seq` (`user_id`, `is_mentioned`, `seq`) USING BTREE,
+    KEY             `idx_expire_time` (`expire_time`) USING BTREE,
+    KEY             `idx_send_time` (`send_time`) USING BTREE
 ) ENGINE = InnoDB
   DEFAULT CHARSET = utf8mb4
   COLLATE = utf8mb4_bin COMMENT ='消息';
+
+-- ----------------------------
+-- Table structure for message_shard
+-- ----------------------------
+DROP TABLE IF EXISTS `message_shard`;
+CREATE TABLE `message_shard`
+(
+    `id`             bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `version`        int(11) NOT NULL DEFAULT '0' COMMENT '当前分表版本，版本0的表名为message_000，其他版本为message_v{version}_000',
+    `table_num`      int(11) NOT NULL COMMENT '当前分表数量',
+    `next_version`   int(11) NOT NULL DEFAULT '0' COMMENT '迁移目标的分表版本',
+    `next_table_num` int(11) NOT NULL DEFAULT '0' COMMENT '迁移目标的分表数量',
+    `status`         tinyint(3) NOT NULL DEFAULT '0' COMMENT '迁移状态，0：正常；1：双写，读当前分表；2：双写，读新分表；3：存量已复制，双写，读当前分表',
+    `copied_max_ids` varchar(4096) NOT NULL DEFAULT '' COMMENT '存量消息复制完成时当前分表每张表的最大主键，逗号分隔',
+    `create_time`    datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time`    datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin COMMENT ='消息分表配置，没有记录时使用配置文件中的分表数量';
+
+-- ----------------------------
+-- Table structure for message_read
+-- ----------------------------
+DROP TABLE IF EXISTS `message_read`;
+CREATE TABLE `message_read`
+(
+    `id`            bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `user_id`       bigint(20) unsigned NOT NULL COMMENT '用户id',
+    `receiver_type` tinyint(3) NOT NULL COMMENT '会话类型,1:个人；2：群组',
+    `receiver_id`   bigint(20) unsigned NOT NULL COMMENT '单聊为对方用户id，群聊为群组id',
+    `read_seq`      bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '已读到的消息序列号',
+    `create_time`   datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time`   datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_id_receiver` (`user_id`, `receiver_type`, `receiver_id`) USING BTREE
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin COMMENT ='会话已读位置';
+
+-- ----------------------------
+-- Table structure for message_reaction
+-- ----------------------------
+DROP TABLE IF EXISTS `message_reaction`;
+CREATE TABLE `message_reaction`
+(
+    `id`          bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `message_id`  bigint(20) unsigned NOT NULL COMMENT '消息id',
+    `user_id`     bigint(20) unsigned NOT NULL COMMENT '回应的用户id',
+    `emoji`       varchar(64)         NOT NULL COMMENT '表情',
+    `create_time` datetime            NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_message_id_user_id_emoji` (`message_id`, `user_id`, `emoji`) USING BTREE
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin COMMENT ='消息表情回应';
+
+-- ----------------------------
+-- Table structure for message_edit
+-- ----------------------------
+DROP TABLE IF EXISTS `message_edit`;
+CREATE TABLE `message_edit`
+(
+    `id`          bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `message_id`  bigint(20) unsigned NOT NULL COMMENT '消息id',
+    `content`     blob                NOT NULL COMMENT '编辑前的消息内容',
+    `send_time`   datetime(3)         NOT NULL COMMENT '这个版本的发送或者编辑时间',
+    `create_time` datetime            NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_message_id` (`message_id`) USING BTREE
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin COMMENT ='消息编辑历史';
+
+-- ----------------------------
+-- Table structure for scheduled_message
+-- ----------------------------
+DROP TABLE IF EXISTS `scheduled_message`;
+CREATE TABLE `scheduled_message`
+(
+    `id`            bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `sender_type`   tinyint(3) NOT NULL COMMENT '发送者类型',
+    `sender_id`     bigint(20) unsigned NOT NULL COMMENT '发送者id',
+    `device_id`     bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '发送者设备id',
+    `receiver_type` tinyint(3) NOT NULL COMMENT '接收者类型,1:个人；2：群组',
+    `receiver_id`   bigint(20) unsigned NOT NULL COMMENT '接收者id,如果是单聊信息，则为user_id，如果是群组消息，则为group_id',
+    `request`       blob                NOT NULL COMMENT '发送消息请求，pb.SendMessageReq序列化后的字节',
+    `send_at`       datetime(3)         NOT NULL COMMENT '定时发送时间',
+    `status`        tinyint(3) NOT NULL DEFAULT '0' COMMENT '状态，0：等待发送；1：发送中；2：已发送；3：已取消；4：发送失败',
+    `seq`           bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '发送成功后发送者的消息序列号',
+    `message_id`    bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '发送成功后的消息id',
+    `error`         varchar(255)        NOT NULL DEFAULT '' COMMENT '发送失败的原因',
+    `create_time`   datetime(3)         NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '创建时间',
+    `update_time`   datetime(3)         NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3) COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_status_send_at` (`status`, `send_at`) USING BTREE,
+    KEY `idx_sender_status` (`sender_type`, `sender_id`, `status`) USING BTREE
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin COMMENT ='定时消息';
+
+-- ----------------------------
+-- Table structure for conversation
+-- ----------------------------
+DROP TABLE IF EXISTS `conversation`;
+CREATE TABLE `conversation`
+(
+    `id`              bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `user_id`         bigint(20) unsigned NOT NULL COMMENT '用户id',
+    `receiver_type`   tinyint(3) NOT NULL COMMENT '会话类型,1:个人；2：群组',
+    `receiver_id`     bigint(20) unsigned NOT NULL COMMENT '单聊为对方用户id，群聊为群组id',
+    `last_seq`        bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '最后一条消息的序列号',
+    `last_message_id` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '最后一条消息的id',
+    `last_time`       datetime(3)   NOT NULL DEFAULT CURRENT_TIMESTAMP(3) COMMENT '最后一条消息的发送时间',
+    `unread_count`    int(11) NOT NULL DEFAULT '0' COMMENT '未读数',
+    `is_top`          tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否置顶',
+    `is_mute`         tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否免打扰',
+    `extra`           varchar(1024) NOT NULL DEFAULT '' COMMENT '附加属性',
+    `ttl`             int(11)       NOT NULL DEFAULT '0' COMMENT '默认阅后即焚时长，单位秒，0表示不开启',
+    `mention_seq`     bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '最新一条未读的@我的消息的序列号，0表示没有',
+    `create_time`     datetime      NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time`     datetime      NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_user_id_receiver` (`user_id`, `receiver_type`, `receiver_id`) USING BTREE,
+    KEY `idx_user_id_top_time` (`user_id`, `is_top`, `last_time`, `id`) USING BTREE
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin COMMENT ='会话';
+
+-- ----------------------------
+-- Table structure for sensitive_word
+-- ----------------------------
+DROP TABLE IF EXISTS `sensitive_word`;
+CREATE TABLE `sensitive_word`
+(
+    `id`          bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `word`        varchar(255) NOT NULL COMMENT '敏感词',
+    `create_time` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time` datetime     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_word` (`word`) USING BTREE
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin COMMENT ='敏感词';
+
+-- ----------------------------
+-- Table structure for webhook_delivery
+-- ----------------------------
+DROP TABLE IF EXISTS `webhook_delivery`;
+CREATE TABLE `webhook_delivery`
+(
+    `id`          bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '自增主键',
+    `event_id`    bigint(20) unsigned NOT NULL COMMENT '事件id',
+    `event_type`  varchar(64)   NOT NULL COMMENT '事件类型',
+    `url`         varchar(255)  NOT NULL COMMENT '接收地址',
+    `payload`     blob          NOT NULL COMMENT '事件JSON',
+    `status`      tinyint(3) NOT NULL DEFAULT '0' COMMENT '投递状态，0：等待投递；1：投递成功；2：死信',
+    `attempts`    int(11) NOT NULL DEFAULT '0' COMMENT '已经投递的次数',
+    `next_time`   datetime(3)   NOT NULL COMMENT '下次投递时间',
+    `last_error`  varchar(1024) NOT NULL DEFAULT '' COMMENT '最后一次投递失败的原因',
+    `create_time` datetime      NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `update_time` datetime      NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_status_next_time` (`status`, `next_time`) USING BTREE,
+    KEY `idx_event_id` (`event_id`) USING BTREE
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4
+  COLLATE = utf8mb4_bin COMMENT ='webhook事件投递记录';
@@ -120,6 +120,22 @@ func (c *TcpClient) SubscribeRoom() {
 	})
 }
 
+func (c *TcpClient) SendMessage(receiverId int64, text string) {
+	content, err := proto.Marshal(&pb.Text{Text: text})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	c.Output(pb.PackageType_PT_SEND_MESSAGE, time.Now().UnixNano(), &pb.SendMessageReq{
+		ReceiverType:   pb.ReceiverType_RT_USER,
+		ReceiverId:     receiverId,
+		MessageType:    pb.MessageType_MT_TEXT,
+		MessageContent: content,
+		SendTime:       util.UnixMilliTime(time.Now()),
+		IsPersist:      true,
+	})
+}
+
 func (c *TcpClient) HandlePackage(bytes []byte) {
 	var output pb.Output
 	err := proto.Unmarshal(bytes, &output)
@@ -172,6 +188,14 @@ func (c *TcpClient) HandlePackage(bytes []byte) {
 			ReceiveTime: util.UnixMilliTime(time.Now()),
 		}
 		c.Output(pb.PackageType_PT_MESSAGE, output.RequestId, &ack)
+	case pb.PackageType_PT_SEND_MESSAGE:
+		resp := pb.SendMessageResp{}
+		err := proto.Unmarshal(output.Data, &resp)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		log.Println("发送消息响应:request_id", output.RequestId, "code", output.Code, "message:", output.Message, "seq:", resp.Seq, "message_id:", resp.MessageId)
 	default:
 		log.Println("switch other")
 	}